@@ -0,0 +1,11 @@
+ephemeral "landb_access_token" "token" {}
+
+# Ephemeral values can only be used in ephemeral contexts such as provider
+# configuration.
+provider "restapi" {
+  uri = "https://landb.cern.ch/api/"
+
+  headers = {
+    Authorization = "Bearer ${ephemeral.landb_access_token.token.access_token}"
+  }
+}
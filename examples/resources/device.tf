@@ -31,12 +31,7 @@ resource "landb_device" "my_device" {
   user = {
     type = "PERSON"
     person = {
-      first_name = "Name"
-      last_name  = "LastName"
-      email      = "Name@cern.ch"
-      username   = "user"
-      department = "IT"
-      group      = "CD"
+      username = "user"
     }
   }
 
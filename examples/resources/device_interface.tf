@@ -0,0 +1,7 @@
+resource "landb_device_interface" "my_interface" {
+  device_name = landb_device.my_device.name
+  name        = "MYHOSTNAME.CERN.CH"
+  service     = "S513-C-IP250"
+  mac_address = "02-00-00-00-00-01"
+  outlet      = "0031-1-006-01"
+}
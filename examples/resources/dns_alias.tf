@@ -0,0 +1,5 @@
+resource "landb_dns_alias" "my_service" {
+  name           = "MY-SERVICE"
+  device_name    = landb_device_interface.my_interface.device_name
+  interface_name = landb_device_interface.my_interface.name
+}
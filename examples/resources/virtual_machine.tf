@@ -0,0 +1,27 @@
+resource "landb_virtual_machine" "my_vm" {
+  name          = "MYVM"
+  parent        = "MYHYPERVISOR"
+  description   = "Terraform-managed virtual machine"
+  type          = "VIRTUAL_MACHINE"
+  dhcp_response = "ALWAYS"
+  manager_lock  = "NO_LOCK"
+
+  operating_system = {
+    family  = "LINUX"
+    version = "9"
+  }
+
+  responsible = {
+    type = "EGROUP"
+    egroup = {
+      name = "terraform-provider-landb"
+    }
+  }
+
+  interfaces = [
+    {
+      name    = "MYVM.CERN.CH"
+      service = "S513-C-VM250"
+    },
+  ]
+}
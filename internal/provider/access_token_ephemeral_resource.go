@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type accessTokenEphemeralResourceModel struct {
+	AccessToken types.String `tfsdk:"access_token"`
+	TokenType   types.String `tfsdk:"token_type"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+type accessTokenEphemeralResource struct {
+	client landb.API
+}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &accessTokenEphemeralResource{}
+}
+
+func (e *accessTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (e *accessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if client, ok := req.ProviderData.(landb.API); ok {
+		e.client = client
+	}
+}
+
+func (e *accessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a LanDB access token with the credentials of the provider",
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token for the LanDB API",
+			},
+			"token_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiry of the token in RFC 3339 format. Null when the expiry is unknown",
+			},
+		},
+	}
+}
+
+func (e *accessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data accessTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := e.client.WithContext(ctx).Token()
+	if errors.Is(err, landb.ErrTokenNotCached) {
+		tflog.Warn(ctx, "Unable to cache the LanDB access token", map[string]any{"error": err.Error()})
+		err = nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching access token", err.Error())
+		return
+	}
+
+	data.AccessToken = types.StringValue(token.AccessToken)
+	data.TokenType = types.StringValue(token.TokenType)
+	data.ExpiresAt = types.StringNull()
+
+	// Static tokens that are not JWTs carry no expiry.
+	if token.ExpiresIn > 0 {
+		expiresAt := time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+		data.ExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -8,7 +8,7 @@ import (
 	"context"
 	"time"
 
-	landb "landb/internal/client"
+	"github.com/barnes-c/terraform-provider-landb/landb"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -16,7 +16,7 @@ import (
 )
 
 type deviceDataSource struct {
-	client *landb.Client
+	client landb.API
 }
 
 func NewDeviceDataSource() datasource.DataSource {
@@ -28,7 +28,7 @@ func (d *deviceDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 }
 
 func (d *deviceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if client, ok := req.ProviderData.(*landb.Client); ok {
+	if client, ok := req.ProviderData.(landb.API); ok {
 		d.client = client
 	}
 }
@@ -89,7 +89,7 @@ func (d *deviceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	device, err := d.client.GetDevice(data.Name.ValueString())
+	device, err := d.client.WithContext(ctx).GetDevice(data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching device", err.Error())
 		return
@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var macAddressRegex = regexp.MustCompile(`^([0-9A-Fa-f]{2}[:-]){5}[0-9A-Fa-f]{2}$`)
+
+type deviceInterfaceResourceModel struct {
+	ChangeComment types.String `tfsdk:"change_comment"`
+	ID            types.String `tfsdk:"id"`
+	DeviceName    types.String `tfsdk:"device_name"`
+	Name          types.String `tfsdk:"name"`
+	MACAddress    types.String `tfsdk:"mac_address"`
+	Outlet        types.String `tfsdk:"outlet"`
+	Service       types.String `tfsdk:"service"`
+	IPv4          types.String `tfsdk:"ipv4"`
+	IPv6          types.String `tfsdk:"ipv6"`
+	Version       types.Int64  `tfsdk:"version"`
+	LastUpdated   types.String `tfsdk:"last_updated"`
+}
+
+type deviceInterfaceResource struct {
+	client landb.API
+}
+
+func NewDeviceInterfaceResource() resource.Resource {
+	return &deviceInterfaceResource{}
+}
+
+func (r *deviceInterfaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_interface"
+}
+
+func (r *deviceInterfaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a network interface of a device",
+		Attributes: map[string]schema.Attribute{
+			"change_comment": changeCommentAttribute(),
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"device_name": schema.StringAttribute{
+				Description:   "Name of the device the interface belongs to.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Description:   "Name of the interface.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"mac_address": schema.StringAttribute{
+				Description: "Hardware address of the interface.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(macAddressRegex, "must be a MAC address such as 02-00-00-00-00-01"),
+				},
+			},
+			"outlet": schema.StringAttribute{
+				Description: "Outlet the interface is connected to.",
+				Optional:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "Network service the addresses are allocated from.",
+				Required:    true,
+			},
+			"ipv4": schema.StringAttribute{
+				Description:   "Requested IPv4 address. Allocated from the service when not set.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{useStateUnlessServiceChanges{}},
+			},
+			"ipv6": schema.StringAttribute{
+				Description:   "Requested IPv6 address. Allocated from the service when not set.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{useStateUnlessServiceChanges{}},
+			},
+			"version":      schema.Int64Attribute{Computed: true},
+			"last_updated": schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (r *deviceInterfaceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+	}
+}
+
+func (r *deviceInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan deviceInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := landb.Interface{
+		Name:       plan.Name.ValueString(),
+		MACAddress: plan.MACAddress.ValueString(),
+		Outlet:     plan.Outlet.ValueString(),
+		Service:    plan.Service.ValueString(),
+		IPv4:       plan.IPv4.ValueString(),
+		IPv6:       plan.IPv6.ValueString(),
+	}
+
+	created, err := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment).CreateInterface(plan.DeviceName.ValueString(), iface)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating device interface", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.DeviceName.ValueString() + "/" + created.Name)
+	plan.IPv4 = types.StringValue(created.IPv4)
+	plan.IPv6 = types.StringValue(created.IPv6)
+	plan.Version = types.Int64Value(int64(created.Version))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *deviceInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state deviceInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface, err := r.client.WithContext(ctx).GetInterface(state.DeviceName.ValueString(), state.Name.ValueString())
+	if errors.Is(err, landb.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading device interface", err.Error())
+		return
+	}
+
+	state.MACAddress = stringOrNull(iface.MACAddress)
+	state.Outlet = stringOrNull(iface.Outlet)
+	state.Service = types.StringValue(iface.Service)
+	state.IPv4 = types.StringValue(iface.IPv4)
+	state.IPv6 = types.StringValue(iface.IPv6)
+	state.Version = types.Int64Value(int64(iface.Version))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *deviceInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if updateChangeCommentOnly(ctx, req, resp) {
+		return
+	}
+
+	var plan deviceInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface := landb.Interface{
+		Name:       plan.Name.ValueString(),
+		MACAddress: plan.MACAddress.ValueString(),
+		Outlet:     plan.Outlet.ValueString(),
+		Service:    plan.Service.ValueString(),
+		IPv4:       plan.IPv4.ValueString(),
+		IPv6:       plan.IPv6.ValueString(),
+	}
+
+	updated, err := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment).UpdateInterface(plan.DeviceName.ValueString(), plan.Name.ValueString(), iface)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating device interface", err.Error())
+		return
+	}
+
+	plan.IPv4 = types.StringValue(updated.IPv4)
+	plan.IPv6 = types.StringValue(updated.IPv6)
+	plan.Version = types.Int64Value(int64(updated.Version))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *deviceInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deviceInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := withChangeComment(r.client.WithContext(ctx), state.ChangeComment).DeleteInterface(state.DeviceName.ValueString(), state.Name.ValueString(), int(state.Version.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting device interface", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *deviceInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	deviceName, name, ok := strings.Cut(req.ID, "/")
+	if !ok || deviceName == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form <device_name>/<interface_name>, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("device_name"), deviceName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// useStateUnlessServiceChanges keeps an allocated address in the plan while
+// the service stays the same. LanDB allocates new addresses when the service
+// changes, so they are left unknown then.
+type useStateUnlessServiceChanges struct{}
+
+func (m useStateUnlessServiceChanges) Description(_ context.Context) string {
+	return "Keeps the prior address unless the service changes."
+}
+
+func (m useStateUnlessServiceChanges) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateUnlessServiceChanges) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var planService, stateService types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("service"), &planService)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("service"), &stateService)...)
+	if resp.Diagnostics.HasError() || !planService.Equal(stateService) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testDeviceInterfaceModel() deviceInterfaceResourceModel {
+	return deviceInterfaceResourceModel{
+		ChangeComment: types.StringNull(),
+		ID:            types.StringValue("TEST-DEVICE/TEST-DEVICE.CERN.CH"),
+		DeviceName:    types.StringValue("TEST-DEVICE"),
+		Name:          types.StringValue("TEST-DEVICE.CERN.CH"),
+		MACAddress:    types.StringNull(),
+		Outlet:        types.StringNull(),
+		Service:       types.StringValue("S513-C-IP250"),
+		IPv4:          types.StringValue("188.184.0.10"),
+		IPv6:          types.StringValue("2001:1458:d00::10"),
+		Version:       types.Int64Value(1),
+		LastUpdated:   types.StringValue(""),
+	}
+}
+
+func TestDeviceInterfaceResourceReadNotFound(t *testing.T) {
+	r := NewDeviceInterfaceResource()
+	client := configureResource(t, r)
+
+	client.EXPECT().GetInterface("TEST-DEVICE", "TEST-DEVICE.CERN.CH").
+		Return(nil, fmt.Errorf("get interface failed: %w", landb.ErrNotFound))
+
+	prior := testDeviceInterfaceModel()
+	req := resource.ReadRequest{State: newState(t, r, prior)}
+	resp := resource.ReadResponse{State: newState(t, r, prior)}
+	r.Read(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.True(t, resp.State.Raw.IsNull())
+}
+
+func TestUseStateUnlessServiceChanges(t *testing.T) {
+	tests := map[string]struct {
+		service string
+		want    types.String
+	}{
+		"same service":    {service: "S513-C-IP250", want: types.StringValue("188.184.0.10")},
+		"changed service": {service: "S513-C-IP251", want: types.StringUnknown()},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewDeviceInterfaceResource()
+
+			prior := testDeviceInterfaceModel()
+			config := testDeviceInterfaceModel()
+			config.Service = types.StringValue(tt.service)
+			config.IPv4 = types.StringNull()
+			plan := config
+			plan.IPv4 = types.StringUnknown()
+
+			configPlan := newPlan(t, r, config)
+			req := planmodifier.StringRequest{
+				Path:        path.Root("ipv4"),
+				Config:      tfsdk.Config{Schema: configPlan.Schema, Raw: configPlan.Raw},
+				ConfigValue: config.IPv4,
+				Plan:        newPlan(t, r, plan),
+				PlanValue:   plan.IPv4,
+				State:       newState(t, r, prior),
+				StateValue:  prior.IPv4,
+			}
+			resp := planmodifier.StringResponse{PlanValue: req.PlanValue}
+			useStateUnlessServiceChanges{}.PlanModifyString(context.Background(), req, &resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			require.Equal(t, tt.want, resp.PlanValue)
+		})
+	}
+}
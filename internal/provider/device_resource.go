@@ -8,17 +8,20 @@ import (
 	"context"
 	"time"
 
-	landb "landb/internal/client"
+	"github.com/barnes-c/terraform-provider-landb/landb"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type deviceResourceModel struct {
+	ChangeComment        types.String `tfsdk:"change_comment"`
 	Description          types.String `tfsdk:"description"`
 	DHCPResponse         types.String `tfsdk:"dhcp_response"`
 	ID                   types.String `tfsdk:"id"`
@@ -45,7 +48,8 @@ type deviceResourceModel struct {
 }
 
 type deviceResource struct {
-	client *landb.Client
+	client   landb.API
+	defaults providerDefaults
 }
 
 func NewDeviceResource() resource.Resource {
@@ -60,20 +64,28 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	resp.Schema = schema.Schema{
 		Description: "Manages a device",
 		Attributes: map[string]schema.Attribute{
+			"change_comment": changeCommentAttribute(),
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"description":              schema.StringAttribute{Optional: true},
-			"dhcp_response":            schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true},
+			"dhcp_response": schema.StringAttribute{
+				Description: "DHCP response policy, e.g. ALWAYS.",
+				Required:    true,
+			},
 			"inventory_number":         schema.StringAttribute{Optional: true},
 			"ipv4_in_dns_and_firewall": schema.BoolAttribute{Required: true},
 			"ipv6_in_dns_and_firewall": schema.BoolAttribute{Required: true},
 			"last_updated":             schema.StringAttribute{Computed: true},
 			"location": schema.SingleNestedAttribute{
 				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"building": schema.StringAttribute{Required: true},
 					"floor":    schema.StringAttribute{Required: true},
@@ -81,7 +93,10 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 				Description: "Physical location of the device.",
 			},
-			"manager_lock": schema.StringAttribute{Required: true},
+			"manager_lock": schema.StringAttribute{
+				Description: "Manager lock of the device, e.g. NO_LOCK.",
+				Required:    true,
+			},
 			"manager":      contactSchemaBlock("Manager of the device"),
 			"manufacturer": schema.StringAttribute{Optional: true},
 			"model":        schema.StringAttribute{Optional: true},
@@ -94,22 +109,83 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					"version": schema.StringAttribute{Optional: true},
 				},
 			},
-			"ownership":     schema.StringAttribute{Required: true},
+			"ownership": schema.StringAttribute{
+				Description: "Ownership of the device, e.g. CERN.",
+				Required:    true,
+			},
 			"parent":        schema.StringAttribute{Optional: true},
 			"responsible":   contactSchemaBlock("Responsible person of the device"),
 			"serial_number": schema.StringAttribute{Optional: true},
 			"tag":           schema.StringAttribute{Optional: true},
-			"type":          schema.StringAttribute{Required: true},
-			"user":          contactSchemaBlock("User of the device"),
-			"version":       schema.Int64Attribute{Computed: true},
-			"zone":          schema.StringAttribute{Required: true},
+			"type": schema.StringAttribute{
+				Description: "Type of the device, e.g. COMPUTER.",
+				Required:    true,
+			},
+			"user":    contactSchemaBlock("User of the device"),
+			"version": schema.Int64Attribute{Computed: true},
+			"zone":    schema.StringAttribute{Required: true},
 		},
 	}
 }
 
+func (r *deviceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config deviceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateContactObject(path.Root("manager"), config.Manager)...)
+	resp.Diagnostics.Append(validateContactObject(path.Root("responsible"), config.Responsible)...)
+	resp.Diagnostics.Append(validateContactObject(path.Root("user"), config.User)...)
+}
+
+func (r *deviceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config deviceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Location = withDefault(config.Location, plan.Location, r.defaults.Location)
+	plan.Manager = withDefault(config.Manager, plan.Manager, r.defaults.Manager)
+	plan.Responsible = withDefault(config.Responsible, plan.Responsible, r.defaults.Responsible)
+	plan.User = withDefault(config.User, plan.User, r.defaults.User)
+
+	var diags diag.Diagnostics
+	plan.Manager, diags = resolveContactObject(ctx, r.client, path.Root("manager"), plan.Manager)
+	resp.Diagnostics.Append(diags...)
+	plan.Responsible, diags = resolveContactObject(ctx, r.client, path.Root("responsible"), plan.Responsible)
+	resp.Diagnostics.Append(diags...)
+	plan.User, diags = resolveContactObject(ctx, r.client, path.Root("user"), plan.User)
+	resp.Diagnostics.Append(diags...)
+
+	if !req.State.Raw.IsNull() {
+		var state deviceResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		// Renaming a device changes its ID, so it cannot be carried over from state.
+		if !plan.Name.Equal(state.Name) {
+			plan.ID = types.StringUnknown()
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *deviceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client, ok := req.ProviderData.(*landb.Client); ok {
-		r.client = client
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+		r.defaults = data.defaults
 	}
 }
 
@@ -125,7 +201,7 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	manager, managerDiags := expandContactObject(ctx, plan.Responsible)
+	manager, managerDiags := expandContactObject(ctx, plan.Manager)
 	resp.Diagnostics.Append(managerDiags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -135,7 +211,7 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	user, userDiags := expandContactObject(ctx, plan.Responsible)
+	user, userDiags := expandContactObject(ctx, plan.User)
 	resp.Diagnostics.Append(userDiags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -169,7 +245,7 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 		Zone:                 plan.Zone.ValueString(),
 	}
 
-	created, err := r.client.CreateDevice(device)
+	created, err := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment).CreateDevice(device)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating device", err.Error())
 		return
@@ -194,7 +270,7 @@ func (r *deviceResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	devicePtr, err := r.client.GetDevice(state.Name.ValueString())
+	devicePtr, err := r.client.WithContext(ctx).GetDevice(state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading device", err.Error())
 		return
@@ -211,8 +287,13 @@ func (r *deviceResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan deviceResourceModel
+	if updateChangeCommentOnly(ctx, req, resp) {
+		return
+	}
+
+	var plan, state deviceResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -222,7 +303,7 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	manager, managerDiags := expandContactObject(ctx, plan.Responsible)
+	manager, managerDiags := expandContactObject(ctx, plan.Manager)
 	resp.Diagnostics.Append(managerDiags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -232,7 +313,7 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	user, userDiags := expandContactObject(ctx, plan.Responsible)
+	user, userDiags := expandContactObject(ctx, plan.User)
 	resp.Diagnostics.Append(userDiags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -266,12 +347,13 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Zone:                 plan.Zone.ValueString(),
 	}
 
-	updated, err := r.client.UpdateDevice(plan.Name.ValueString(), device)
+	updated, err := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment).UpdateDevice(state.Name.ValueString(), device)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating device", err.Error())
 		return
 	}
 
+	plan.ID = types.StringValue(updated.Name)
 	plan.Version = types.Int64Value(int64(updated.Version))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
@@ -283,7 +365,11 @@ func (r *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_ = r.client.DeleteDevice(state.Name.ValueString(), int(state.Version.ValueInt64()))
+	err := withChangeComment(r.client.WithContext(ctx), state.ChangeComment).DeleteDevice(state.Name.ValueString(), int(state.Version.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting device", err.Error())
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -0,0 +1,272 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+	"github.com/barnes-c/terraform-provider-landb/landb/landbmock"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testResponsible = landb.Contact{
+	Type:   "EGROUP",
+	EGroup: landb.EGroup{Name: "landb-admins", Email: "landb-admins@cern.ch"},
+}
+
+func testDeviceModel() deviceResourceModel {
+	return deviceResourceModel{
+		ChangeComment:        types.StringNull(),
+		Description:          types.StringValue("Test device"),
+		DHCPResponse:         types.StringValue("ALWAYS"),
+		ID:                   types.StringUnknown(),
+		InventoryNumber:      types.StringNull(),
+		IPv4InDNSAndFirewall: types.BoolValue(true),
+		IPv6InDNSAndFirewall: types.BoolValue(false),
+		LastUpdated:          types.StringUnknown(),
+		Location:             flattenLocation(landb.Location{Building: "0031", Floor: "S", Room: "028"}),
+		Manager:              flattenContactObject(testResponsible),
+		ManagerLock:          types.StringValue("NO_LOCK"),
+		Manufacturer:         types.StringValue("DELL"),
+		Model:                types.StringValue("POWEREDGE R640"),
+		Name:                 types.StringValue("TEST-DEVICE"),
+		OperatingSystem:      flattenOperatingSystem(landb.OperatingSystem{Family: "LINUX", Version: "RHEL9"}),
+		Ownership:            types.StringValue("CERN"),
+		Parent:               types.StringNull(),
+		Responsible:          flattenContactObject(testResponsible),
+		SerialNumber:         types.StringValue("SN12345"),
+		Tag:                  types.StringNull(),
+		Type:                 types.StringValue("COMPUTER"),
+		User:                 flattenContactObject(testResponsible),
+		Version:              types.Int64Unknown(),
+		Zone:                 types.StringValue("0031"),
+	}
+}
+
+func testDevice() landb.Device {
+	return landb.Device{
+		Name:                 "TEST-DEVICE",
+		SerialNumber:         "SN12345",
+		Description:          "Test device",
+		Zone:                 "0031",
+		DHCPResponse:         "ALWAYS",
+		IPv4InDNSAndFirewall: true,
+		ManagerLock:          "NO_LOCK",
+		Ownership:            "CERN",
+		Location:             landb.Location{Building: "0031", Floor: "S", Room: "028"},
+		Type:                 "COMPUTER",
+		Manufacturer:         "DELL",
+		Model:                "POWEREDGE R640",
+		OperatingSystem:      landb.OperatingSystem{Family: "LINUX", Version: "RHEL9"},
+		Manager:              testResponsible,
+		Responsible:          testResponsible,
+		User:                 testResponsible,
+	}
+}
+
+func TestDeviceResourceCreate(t *testing.T) {
+	r := NewDeviceResource()
+	client := configureResource(t, r)
+
+	created := testDevice()
+	created.Version = 1
+	client.EXPECT().CreateDevice(testDevice()).Return(created, nil)
+
+	req := resource.CreateRequest{Plan: newPlan(t, r, testDeviceModel())}
+	resp := resource.CreateResponse{State: newState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state deviceResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, "TEST-DEVICE", state.ID.ValueString())
+	require.Equal(t, int64(1), state.Version.ValueInt64())
+}
+
+func TestDeviceResourceUpdateRename(t *testing.T) {
+	r := NewDeviceResource()
+	client := configureResource(t, r)
+
+	prior := testDeviceModel()
+	prior.ID = types.StringValue("OLD-DEVICE")
+	prior.Name = types.StringValue("OLD-DEVICE")
+	prior.Version = types.Int64Value(3)
+	prior.LastUpdated = types.StringValue("")
+
+	expected := testDevice()
+	updated := expected
+	updated.Version = 4
+	client.EXPECT().UpdateDevice("OLD-DEVICE", expected).Return(&updated, nil)
+
+	req := resource.UpdateRequest{
+		Plan:  newPlan(t, r, testDeviceModel()),
+		State: newState(t, r, prior),
+	}
+	resp := resource.UpdateResponse{State: newState(t, r, prior)}
+	r.Update(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state deviceResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, "TEST-DEVICE", state.ID.ValueString())
+	require.Equal(t, int64(4), state.Version.ValueInt64())
+}
+
+func TestDeviceResourceDeleteWithChangeComment(t *testing.T) {
+	r := NewDeviceResource()
+	client := configureResource(t, r)
+	commented := landbmock.NewMockAPI(gomock.NewController(t))
+
+	prior := testDeviceModel()
+	prior.ChangeComment = types.StringValue("RITM0123456")
+	prior.ID = types.StringValue("TEST-DEVICE")
+	prior.Version = types.Int64Value(2)
+	prior.LastUpdated = types.StringValue("")
+
+	client.EXPECT().WithChangeComment("RITM0123456").Return(commented)
+	commented.EXPECT().DeleteDevice("TEST-DEVICE", 2).Return(nil)
+
+	req := resource.DeleteRequest{State: newState(t, r, prior)}
+	resp := resource.DeleteResponse{State: newState(t, r, prior)}
+	r.Delete(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.True(t, resp.State.Raw.IsNull())
+}
+
+func TestDeviceResourceCreateSendsEachContact(t *testing.T) {
+	r := NewDeviceResource()
+	client := configureResource(t, r)
+
+	manager := landb.Contact{Type: "EGROUP", EGroup: landb.EGroup{Name: "landb-managers", Email: "landb-managers@cern.ch"}}
+	user := landb.Contact{Type: "RESERVED", Reserved: landb.Reserved{FirstName: "John", LastName: "Doe"}}
+
+	expected := testDevice()
+	expected.Manager = manager
+	expected.User = user
+	client.EXPECT().CreateDevice(expected).Return(expected, nil)
+
+	model := testDeviceModel()
+	model.Manager = flattenContactObject(manager)
+	model.User = flattenContactObject(user)
+
+	req := resource.CreateRequest{Plan: newPlan(t, r, model)}
+	resp := resource.CreateResponse{State: newState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
+
+func TestDeviceResourceModifyPlanDefaults(t *testing.T) {
+	def := landb.Contact{Type: "EGROUP", EGroup: landb.EGroup{Name: "default-managers", Email: "default-managers@cern.ch"}}
+	defLocation := landb.Location{Building: "0513", Floor: "R", Room: "050"}
+
+	tests := map[string]struct {
+		configured bool
+		want       deviceResourceModel
+	}{
+		"configured": {
+			configured: true,
+			want:       testDeviceModel(),
+		},
+		"inherited": {
+			want: func() deviceResourceModel {
+				m := testDeviceModel()
+				m.Manager = flattenContactObject(def)
+				m.Location = flattenLocation(defLocation)
+				return m
+			}(),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewDeviceResource()
+			var configureResp resource.ConfigureResponse
+			r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{
+				ProviderData: &providerData{defaults: providerDefaults{
+					Manager:  flattenContactObject(def),
+					Location: flattenLocation(defLocation),
+				}},
+			}, &configureResp)
+
+			config := testDeviceModel()
+			plan := testDeviceModel()
+			if !tt.configured {
+				config.Manager = types.ObjectNull(contactAttrTypes)
+				config.Location = types.ObjectNull(locationAttrTypes())
+				plan.Manager = types.ObjectUnknown(contactAttrTypes)
+				plan.Location = types.ObjectUnknown(locationAttrTypes())
+			}
+
+			configPlan := newPlan(t, r, config)
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: configPlan.Schema, Raw: configPlan.Raw},
+				Plan:   newPlan(t, r, plan),
+				State:  newState(t, r, nil),
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(context.Background(), req, &resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var got deviceResourceModel
+			require.False(t, resp.Plan.Get(context.Background(), &got).HasError())
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeviceResourceUpdateChangeCommentOnly(t *testing.T) {
+	r := NewDeviceResource()
+	configureResource(t, r)
+
+	prior := testDeviceModel()
+	prior.ID = types.StringValue("TEST-DEVICE")
+	prior.Version = types.Int64Value(3)
+	prior.LastUpdated = types.StringValue("")
+
+	plan := prior
+	plan.ChangeComment = types.StringValue("RITM0123456")
+	plan.LastUpdated = types.StringUnknown()
+
+	req := resource.UpdateRequest{
+		Plan:  newPlan(t, r, plan),
+		State: newState(t, r, prior),
+	}
+	resp := resource.UpdateResponse{State: newState(t, r, prior)}
+	r.Update(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	want := prior
+	want.ChangeComment = types.StringValue("RITM0123456")
+
+	var state deviceResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, want, state)
+}
+
+func TestDeviceResourceDeleteError(t *testing.T) {
+	r := NewDeviceResource()
+	client := configureResource(t, r)
+
+	prior := testDeviceModel()
+	prior.ID = types.StringValue("TEST-DEVICE")
+	prior.Version = types.Int64Value(2)
+	prior.LastUpdated = types.StringValue("")
+
+	client.EXPECT().DeleteDevice("TEST-DEVICE", 2).Return(errors.New("delete device failed: version mismatch"))
+
+	req := resource.DeleteRequest{State: newState(t, r, prior)}
+	resp := resource.DeleteResponse{State: newState(t, r, prior)}
+	r.Delete(context.Background(), req, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+	require.False(t, resp.State.Raw.IsNull())
+}
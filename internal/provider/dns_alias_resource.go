@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dnsAliasResourceModel struct {
+	ChangeComment types.String `tfsdk:"change_comment"`
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	DeviceName    types.String `tfsdk:"device_name"`
+	InterfaceName types.String `tfsdk:"interface_name"`
+	Scope         types.String `tfsdk:"scope"`
+	Version       types.Int64  `tfsdk:"version"`
+	LastUpdated   types.String `tfsdk:"last_updated"`
+}
+
+type dnsAliasResource struct {
+	client landb.API
+}
+
+func NewDNSAliasResource() resource.Resource {
+	return &dnsAliasResource{}
+}
+
+func (r *dnsAliasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_alias"
+}
+
+func (r *dnsAliasResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a DNS alias pointing at a device interface",
+		Attributes: map[string]schema.Attribute{
+			"change_comment": changeCommentAttribute(),
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description:   "Name of the alias.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Name of the device the alias points at.",
+				Required:    true,
+			},
+			"interface_name": schema.StringAttribute{
+				Description: "Name of the device interface the alias points at.",
+				Required:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "Address families the alias is published for. Defaults to the scope LanDB assigns.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version":      schema.Int64Attribute{Computed: true},
+			"last_updated": schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (r *dnsAliasResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+	}
+}
+
+func (r *dnsAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnsAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias := landb.DNSAlias{
+		Name:          plan.Name.ValueString(),
+		DeviceName:    plan.DeviceName.ValueString(),
+		InterfaceName: plan.InterfaceName.ValueString(),
+		Scope:         plan.Scope.ValueString(),
+	}
+
+	created, err := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment).CreateDNSAlias(alias)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating DNS alias", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.Name)
+	plan.Scope = types.StringValue(created.Scope)
+	plan.Version = types.Int64Value(int64(created.Version))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *dnsAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnsAliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := r.client.WithContext(ctx).GetDNSAlias(state.ID.ValueString())
+	if errors.Is(err, landb.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading DNS alias", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(alias.Name)
+	state.DeviceName = types.StringValue(alias.DeviceName)
+	state.InterfaceName = types.StringValue(alias.InterfaceName)
+	state.Scope = types.StringValue(alias.Scope)
+	state.Version = types.Int64Value(int64(alias.Version))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dnsAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if updateChangeCommentOnly(ctx, req, resp) {
+		return
+	}
+
+	var plan dnsAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias := landb.DNSAlias{
+		Name:          plan.Name.ValueString(),
+		DeviceName:    plan.DeviceName.ValueString(),
+		InterfaceName: plan.InterfaceName.ValueString(),
+		Scope:         plan.Scope.ValueString(),
+	}
+
+	updated, err := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment).UpdateDNSAlias(plan.ID.ValueString(), alias)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating DNS alias", err.Error())
+		return
+	}
+
+	plan.Scope = types.StringValue(updated.Scope)
+	plan.Version = types.Int64Value(int64(updated.Version))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *dnsAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dnsAliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := withChangeComment(r.client.WithContext(ctx), state.ChangeComment).DeleteDNSAlias(state.ID.ValueString(), int(state.Version.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting DNS alias", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *dnsAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDNSAliasResourceReadNotFound(t *testing.T) {
+	r := NewDNSAliasResource()
+	client := configureResource(t, r)
+
+	client.EXPECT().GetDNSAlias("test-alias").Return(nil, fmt.Errorf("get dns alias failed: %w", landb.ErrNotFound))
+
+	prior := dnsAliasResourceModel{
+		ChangeComment: types.StringNull(),
+		ID:            types.StringValue("test-alias"),
+		Name:          types.StringValue("test-alias"),
+		DeviceName:    types.StringValue("TEST-DEVICE"),
+		InterfaceName: types.StringValue("TEST-DEVICE.CERN.CH"),
+		Scope:         types.StringValue(""),
+		Version:       types.Int64Value(1),
+		LastUpdated:   types.StringValue(""),
+	}
+	req := resource.ReadRequest{State: newState(t, r, prior)}
+	resp := resource.ReadResponse{State: newState(t, r, prior)}
+	r.Read(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.True(t, resp.State.Raw.IsNull())
+}
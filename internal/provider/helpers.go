@@ -6,21 +6,41 @@ package provider
 
 import (
 	"context"
+	"regexp"
+	"strings"
 
-	landb "landb/internal/client"
+	"github.com/barnes-c/terraform-provider-landb/landb"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
+	contactTypes = []string{"PERSON", "EGROUP", "RESERVED"}
+
+	// contactBlocks maps each contact type to its nested block and the
+	// fields that must be set within it.
+	contactBlocks = map[string]struct {
+		name     string
+		required []string
+	}{
+		"PERSON":   {name: "person", required: []string{"username"}},
+		"EGROUP":   {name: "egroup", required: []string{"name"}},
+		"RESERVED": {name: "reserved", required: []string{"first_name", "last_name"}},
+	}
+
 	personAttrTypes = map[string]attr.Type{
 		"first_name": types.StringType,
 		"last_name":  types.StringType,
@@ -61,6 +81,9 @@ func contactSchemaBlock(description string) schema.SingleNestedAttribute {
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(contactTypes...),
+				},
 			},
 			"person": schema.SingleNestedAttribute{
 				Description: "Details if type == PERSON",
@@ -106,6 +129,180 @@ func contactSchemaBlock(description string) schema.SingleNestedAttribute {
 	}
 }
 
+// withDefault returns the provider-level default for an attribute that is
+// absent from the resource configuration.
+func withDefault(config, plan, def types.Object) types.Object {
+	if config.IsNull() && !def.IsNull() {
+		return def
+	}
+	return plan
+}
+
+func oneOfDescription(description string, values []string) string {
+	return description + " One of " + strings.Join(values, ", ") + "."
+}
+
+func validateContactObject(p path.Path, o types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if o.IsNull() || o.IsUnknown() {
+		return diags
+	}
+
+	elems := o.Attributes()
+	typ, _ := elems["type"].(types.String)
+	if typ.IsUnknown() {
+		return diags
+	}
+	if typ.IsNull() {
+		diags.AddAttributeError(
+			p.AtName("type"),
+			"Missing contact type",
+			"The contact type must be set to one of "+strings.Join(contactTypes, ", ")+".",
+		)
+		return diags
+	}
+
+	expected, ok := contactBlocks[typ.ValueString()]
+	if !ok {
+		// Unknown types are reported by the attribute validator.
+		return diags
+	}
+
+	for _, other := range contactTypes {
+		block := contactBlocks[other]
+		if block.name == expected.name {
+			continue
+		}
+		if b, _ := elems[block.name].(types.Object); !b.IsNull() {
+			diags.AddAttributeError(
+				p.AtName(block.name),
+				"Unexpected contact block",
+				"The "+block.name+" block cannot be set on a contact of type "+typ.ValueString()+".",
+			)
+		}
+	}
+
+	b, _ := elems[expected.name].(types.Object)
+	if b.IsUnknown() {
+		return diags
+	}
+	if b.IsNull() {
+		diags.AddAttributeError(
+			p.AtName(expected.name),
+			"Missing contact block",
+			"A contact of type "+typ.ValueString()+" requires the "+expected.name+" block.",
+		)
+		return diags
+	}
+
+	fields := b.Attributes()
+	for _, field := range expected.required {
+		v, _ := fields[field].(types.String)
+		if v.IsUnknown() {
+			continue
+		}
+		if v.ValueString() == "" {
+			diags.AddAttributeError(
+				p.AtName(expected.name).AtName(field),
+				"Missing contact attribute",
+				"The "+field+" attribute is required in the "+expected.name+" block.",
+			)
+		}
+	}
+
+	return diags
+}
+
+// resolveContactObject fills in the unset person or e-group details of a
+// contact from LanDB, so that only the username or e-group name has to be
+// configured.
+func resolveContactObject(ctx context.Context, client landb.API, p path.Path, o types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if client == nil || o.IsNull() || o.IsUnknown() {
+		return o, diags
+	}
+	client = client.WithContext(ctx)
+
+	elems := o.Attributes()
+	typ, _ := elems["type"].(types.String)
+
+	switch typ.ValueString() {
+	case "PERSON":
+		pObj, _ := elems["person"].(types.Object)
+		if pObj.IsNull() || pObj.IsUnknown() {
+			return o, diags
+		}
+		attrs := pObj.Attributes()
+		username, _ := attrs["username"].(types.String)
+		if username.IsUnknown() || username.ValueString() == "" || !hasUnsetAttribute(attrs) {
+			return o, diags
+		}
+
+		person, err := client.GetPerson(username.ValueString())
+		if err != nil {
+			diags.AddAttributeError(p.AtName("person").AtName("username"), "Error resolving person", err.Error())
+			return o, diags
+		}
+
+		resolved := map[string]attr.Value{
+			"first_name": types.StringValue(person.FirstName),
+			"last_name":  types.StringValue(person.LastName),
+			"email":      types.StringValue(person.Email),
+			"username":   username,
+			"department": types.StringValue(person.Department),
+			"group":      types.StringValue(person.Group),
+		}
+		for k, v := range attrs {
+			if !v.IsNull() && !v.IsUnknown() {
+				resolved[k] = v
+			}
+		}
+		elems["person"] = types.ObjectValueMust(personAttrTypes, resolved)
+	case "EGROUP":
+		eObj, _ := elems["egroup"].(types.Object)
+		if eObj.IsNull() || eObj.IsUnknown() {
+			return o, diags
+		}
+		attrs := eObj.Attributes()
+		name, _ := attrs["name"].(types.String)
+		if name.IsUnknown() || name.ValueString() == "" || !hasUnsetAttribute(attrs) {
+			return o, diags
+		}
+
+		egroup, err := client.GetEGroup(name.ValueString())
+		if err != nil {
+			diags.AddAttributeError(p.AtName("egroup").AtName("name"), "Error resolving e-group", err.Error())
+			return o, diags
+		}
+
+		resolved := map[string]attr.Value{
+			"name":  name,
+			"email": types.StringValue(egroup.Email),
+		}
+		for k, v := range attrs {
+			if !v.IsNull() && !v.IsUnknown() {
+				resolved[k] = v
+			}
+		}
+		elems["egroup"] = types.ObjectValueMust(egroupAttrTypes, resolved)
+	default:
+		return o, diags
+	}
+
+	return types.ObjectValueMust(contactAttrTypes, elems), diags
+}
+
+func hasUnsetAttribute(attrs map[string]attr.Value) bool {
+	for _, v := range attrs {
+		if v.IsNull() || v.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
 func expandContactObject(ctx context.Context, o types.Object) (landb.Contact, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -204,6 +401,66 @@ func flattenContactObject(c landb.Contact) types.Object {
 	return obj
 }
 
+// stringOrNull maps the empty strings the API returns for unset fields to
+// null, so optional attributes that are not configured stay unset.
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// changeCommentPattern keeps change comments to a single line of printable
+// ASCII, as they are sent in a header.
+var changeCommentPattern = regexp.MustCompile(`^[\x20-\x7E]*$`)
+
+func changeCommentAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Reason for the change recorded in the LanDB history, e.g. a ticket number. Overrides the provider change_comment",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(changeCommentPattern, "must be a single line of printable ASCII characters"),
+		},
+	}
+}
+
+// withChangeComment returns client annotated with comment when it is set,
+// keeping the provider's change_comment otherwise.
+func withChangeComment(client landb.API, comment types.String) landb.API {
+	if comment.IsNull() || comment.IsUnknown() || comment.ValueString() == "" {
+		return client
+	}
+	return client.WithChangeComment(comment.ValueString())
+}
+
+// updateChangeCommentOnly saves the planned change_comment to state without
+// calling LanDB when it is the only attribute that changed, as there is
+// nothing to update then. It reports whether it handled the update.
+func updateChangeCommentOnly(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) bool {
+	commentPath := tftypes.NewAttributePath().WithAttributeName("change_comment")
+
+	// Computed values the plan leaves unknown keep their state value.
+	merged, err := tftypes.Transform(req.Plan.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !p.Equal(commentPath) && v.IsKnown() {
+			return v, nil
+		}
+		prior, _, err := tftypes.WalkAttributePath(req.State.Raw, p)
+		if err != nil {
+			return v, nil
+		}
+		return prior.(tftypes.Value), nil
+	})
+	if err != nil || !merged.Equal(req.State.Raw) {
+		return false
+	}
+
+	var comment types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("change_comment"), &comment)...)
+	resp.State.Raw = req.State.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("change_comment"), comment)...)
+	return true
+}
+
 func operatingSystemAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"family":  types.StringType,
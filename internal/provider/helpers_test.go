@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+	"github.com/barnes-c/terraform-provider-landb/landb/landbmock"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// contactObject builds a contact of typ with the given blocks set, e.g.
+// {"egroup": {"name": "landb-admins"}}. Unlisted blocks and attributes are
+// null.
+func contactObject(typ attr.Value, blocks map[string]map[string]string) types.Object {
+	block := func(name string, attrTypes map[string]attr.Type) attr.Value {
+		fields, ok := blocks[name]
+		if !ok {
+			return types.ObjectNull(attrTypes)
+		}
+		elems := map[string]attr.Value{}
+		for k := range attrTypes {
+			if v, ok := fields[k]; ok {
+				elems[k] = types.StringValue(v)
+			} else {
+				elems[k] = types.StringNull()
+			}
+		}
+		return types.ObjectValueMust(attrTypes, elems)
+	}
+
+	return types.ObjectValueMust(contactAttrTypes, map[string]attr.Value{
+		"type":     typ,
+		"person":   block("person", personAttrTypes),
+		"egroup":   block("egroup", egroupAttrTypes),
+		"reserved": block("reserved", reservedAttrTypes),
+	})
+}
+
+// errorPaths returns the attribute paths of the errors in diags, in order.
+func errorPaths(diags diag.Diagnostics) []string {
+	var paths []string
+	for _, d := range diags.Errors() {
+		if d, ok := d.(diag.DiagnosticWithPath); ok {
+			paths = append(paths, d.Path().String())
+		}
+	}
+	return paths
+}
+
+func TestValidateContactObject(t *testing.T) {
+	tests := map[string]struct {
+		contact types.Object
+		errors  []string
+	}{
+		"null": {
+			contact: types.ObjectNull(contactAttrTypes),
+		},
+		"unknown type": {
+			contact: contactObject(types.StringUnknown(), nil),
+		},
+		"missing type": {
+			contact: contactObject(types.StringNull(), nil),
+			errors:  []string{"manager.type"},
+		},
+		"egroup": {
+			contact: contactObject(types.StringValue("EGROUP"), map[string]map[string]string{"egroup": {"name": "landb-admins"}}),
+		},
+		"person": {
+			contact: contactObject(types.StringValue("PERSON"), map[string]map[string]string{"person": {"username": "jdoe"}}),
+		},
+		"reserved": {
+			contact: contactObject(types.StringValue("RESERVED"), map[string]map[string]string{"reserved": {"first_name": "John", "last_name": "Doe"}}),
+		},
+		"missing block": {
+			contact: contactObject(types.StringValue("EGROUP"), nil),
+			errors:  []string{"manager.egroup"},
+		},
+		"missing attribute": {
+			contact: contactObject(types.StringValue("RESERVED"), map[string]map[string]string{"reserved": {"first_name": "John"}}),
+			errors:  []string{"manager.reserved.last_name"},
+		},
+		"blocks of other types": {
+			contact: contactObject(types.StringValue("RESERVED"), map[string]map[string]string{
+				"person":   {"username": "jdoe"},
+				"egroup":   {"name": "landb-admins"},
+				"reserved": {"first_name": "John", "last_name": "Doe"},
+			}),
+			errors: []string{"manager.person", "manager.egroup"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Repeat to catch diagnostics that depend on map iteration order.
+			for range 10 {
+				diags := validateContactObject(path.Root("manager"), tt.contact)
+				require.Equal(t, tt.errors, errorPaths(diags))
+			}
+		})
+	}
+}
+
+func TestDeviceResourceValidateConfig(t *testing.T) {
+	egroup := contactObject(types.StringValue("EGROUP"), map[string]map[string]string{"egroup": {"name": "landb-admins"}})
+
+	tests := map[string]struct {
+		manager, responsible, user types.Object
+		errors                     []string
+	}{
+		"valid": {
+			manager:     egroup,
+			responsible: egroup,
+			user:        types.ObjectNull(contactAttrTypes),
+		},
+		"invalid contacts": {
+			manager:     contactObject(types.StringValue("PERSON"), nil),
+			responsible: egroup,
+			user:        contactObject(types.StringValue("EGROUP"), map[string]map[string]string{"egroup": {}}),
+			errors:      []string{"manager.person", "user.egroup.name"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewDeviceResource()
+			model := testDeviceModel()
+			model.Manager = tt.manager
+			model.Responsible = tt.responsible
+			model.User = tt.user
+
+			plan := newPlan(t, r, model)
+			req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}
+			var resp resource.ValidateConfigResponse
+			r.(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(), req, &resp)
+			require.Equal(t, tt.errors, errorPaths(resp.Diagnostics))
+		})
+	}
+}
+
+func TestResolveContactObject(t *testing.T) {
+	person := contactObject(types.StringValue("PERSON"), map[string]map[string]string{"person": {"username": "jdoe", "email": "jdoe@example.com"}})
+
+	t.Run("person", func(t *testing.T) {
+		client := landbmock.NewMockAPI(gomock.NewController(t))
+		client.EXPECT().WithContext(gomock.Any()).Return(client)
+		client.EXPECT().GetPerson("jdoe").Return(&landb.Person{
+			Username:   "jdoe",
+			FirstName:  "John",
+			LastName:   "Doe",
+			Email:      "john.doe@cern.ch",
+			Department: "IT",
+			Group:      "CD",
+		}, nil)
+
+		resolved, diags := resolveContactObject(context.Background(), client, path.Root("manager"), person)
+		require.False(t, diags.HasError(), diags)
+		require.Equal(t, contactObject(types.StringValue("PERSON"), map[string]map[string]string{"person": {
+			"username":   "jdoe",
+			"first_name": "John",
+			"last_name":  "Doe",
+			"email":      "jdoe@example.com",
+			"department": "IT",
+			"group":      "CD",
+		}}), resolved)
+	})
+
+	t.Run("unknown person", func(t *testing.T) {
+		client := landbmock.NewMockAPI(gomock.NewController(t))
+		client.EXPECT().WithContext(gomock.Any()).Return(client)
+		client.EXPECT().GetPerson("jdoe").Return(nil, errors.New("get person failed: person not found"))
+
+		resolved, diags := resolveContactObject(context.Background(), client, path.Root("manager"), person)
+		require.Equal(t, []string{"manager.person.username"}, errorPaths(diags))
+		require.Equal(t, person, resolved)
+	})
+}
+
+func TestWithDefault(t *testing.T) {
+	configured := contactObject(types.StringValue("EGROUP"), map[string]map[string]string{"egroup": {"name": "configured"}})
+	def := contactObject(types.StringValue("EGROUP"), map[string]map[string]string{"egroup": {"name": "default"}})
+	null := types.ObjectNull(contactAttrTypes)
+	unknown := types.ObjectUnknown(contactAttrTypes)
+
+	tests := map[string]struct {
+		config, plan, def, want types.Object
+	}{
+		"configured value wins":     {config: configured, plan: configured, def: def, want: configured},
+		"default fills unset":       {config: null, plan: unknown, def: def, want: def},
+		"no default keeps the plan": {config: null, plan: unknown, def: null, want: unknown},
+		"unknown config keeps plan": {config: unknown, plan: unknown, def: def, want: unknown},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.want, withDefault(tt.config, tt.plan, tt.def))
+		})
+	}
+}
+
+func TestChangeCommentPattern(t *testing.T) {
+	tests := map[string]bool{
+		"":                         true,
+		"RITM0123456":              true,
+		"Move to rack 12, see #42": true,
+		"first line\nsecond line":  false,
+		"carriage\rreturn":         false,
+		"tab\tseparated":           false,
+		"bell\a":                   false,
+		"Ünïcödé":                  false,
+	}
+
+	for comment, valid := range tests {
+		require.Equal(t, valid, changeCommentPattern.MatchString(comment), comment)
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type locationsDataSourceModel struct {
+	ID        types.String    `tfsdk:"id"`
+	Building  types.String    `tfsdk:"building"`
+	Locations []locationModel `tfsdk:"locations"`
+}
+
+type locationModel struct {
+	Building types.String `tfsdk:"building"`
+	Floor    types.String `tfsdk:"floor"`
+	Room     types.String `tfsdk:"room"`
+}
+
+type locationsDataSource struct {
+	client landb.API
+}
+
+func NewLocationsDataSource() datasource.DataSource {
+	return &locationsDataSource{}
+}
+
+func (d *locationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_locations"
+}
+
+func (d *locationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(landb.API); ok {
+		d.client = client
+	}
+}
+
+func (d *locationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the buildings, floors and rooms known to LanDB",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true},
+			"building": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return locations in this building",
+			},
+			"locations": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"building": schema.StringAttribute{Computed: true},
+						"floor":    schema.StringAttribute{Computed: true},
+						"room":     schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *locationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data locationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locations, err := d.client.WithContext(ctx).ListLocations(data.Building.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing locations", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue("locations/" + data.Building.ValueString())
+	data.Locations = make([]locationModel, 0, len(locations))
+	for _, l := range locations {
+		data.Locations = append(data.Locations, locationModel{
+			Building: types.StringValue(l.Building),
+			Floor:    types.StringValue(l.Floor),
+			Room:     types.StringValue(l.Room),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
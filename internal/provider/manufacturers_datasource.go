@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type manufacturersDataSourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Manufacturers []types.String `tfsdk:"manufacturers"`
+}
+
+type manufacturersDataSource struct {
+	client landb.API
+}
+
+func NewManufacturersDataSource() datasource.DataSource {
+	return &manufacturersDataSource{}
+}
+
+func (d *manufacturersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_manufacturers"
+}
+
+func (d *manufacturersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(landb.API); ok {
+		d.client = client
+	}
+}
+
+func (d *manufacturersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the device manufacturers known to LanDB",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true},
+			"manufacturers": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *manufacturersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data manufacturersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	manufacturers, err := d.client.WithContext(ctx).ListManufacturers()
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing manufacturers", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue("manufacturers")
+	data.Manufacturers = make([]types.String, 0, len(manufacturers))
+	for _, m := range manufacturers {
+		data.Manufacturers = append(data.Manufacturers, types.StringValue(m.Name))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
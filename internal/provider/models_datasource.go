@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type modelsDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Manufacturer types.String `tfsdk:"manufacturer"`
+	Models       []modelModel `tfsdk:"models"`
+}
+
+type modelModel struct {
+	Name         types.String `tfsdk:"name"`
+	Manufacturer types.String `tfsdk:"manufacturer"`
+}
+
+type modelsDataSource struct {
+	client landb.API
+}
+
+func NewModelsDataSource() datasource.DataSource {
+	return &modelsDataSource{}
+}
+
+func (d *modelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_models"
+}
+
+func (d *modelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(landb.API); ok {
+		d.client = client
+	}
+}
+
+func (d *modelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the device models known to LanDB",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true},
+			"manufacturer": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return models of this manufacturer",
+			},
+			"models": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":         schema.StringAttribute{Computed: true},
+						"manufacturer": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *modelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	models, err := d.client.WithContext(ctx).ListModels(data.Manufacturer.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing models", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue("models/" + data.Manufacturer.ValueString())
+	data.Models = make([]modelModel, 0, len(models))
+	for _, m := range models {
+		data.Models = append(data.Models, modelModel{
+			Name:         types.StringValue(m.Name),
+			Manufacturer: types.StringValue(m.Manufacturer),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
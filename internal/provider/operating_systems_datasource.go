@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type operatingSystemsDataSourceModel struct {
+	ID               types.String           `tfsdk:"id"`
+	Family           types.String           `tfsdk:"family"`
+	OperatingSystems []operatingSystemModel `tfsdk:"operating_systems"`
+}
+
+type operatingSystemModel struct {
+	Family  types.String `tfsdk:"family"`
+	Version types.String `tfsdk:"version"`
+}
+
+type operatingSystemsDataSource struct {
+	client landb.API
+}
+
+func NewOperatingSystemsDataSource() datasource.DataSource {
+	return &operatingSystemsDataSource{}
+}
+
+func (d *operatingSystemsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operating_systems"
+}
+
+func (d *operatingSystemsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(landb.API); ok {
+		d.client = client
+	}
+}
+
+func (d *operatingSystemsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the operating system families and versions known to LanDB",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true},
+			"family": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return versions of this operating system family",
+			},
+			"operating_systems": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"family":  schema.StringAttribute{Computed: true},
+						"version": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *operatingSystemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data operatingSystemsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	systems, err := d.client.WithContext(ctx).ListOperatingSystems(data.Family.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing operating systems", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue("operating_systems/" + data.Family.ValueString())
+	data.OperatingSystems = make([]operatingSystemModel, 0, len(systems))
+	for _, os := range systems {
+		data.OperatingSystems = append(data.OperatingSystems, operatingSystemModel{
+			Family:  types.StringValue(os.Family),
+			Version: types.StringValue(os.Version),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
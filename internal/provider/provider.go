@@ -6,21 +6,33 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
-	landb "landb/internal/client"
+	"github.com/barnes-c/terraform-provider-landb/landb"
 
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ provider.Provider = &landbProvider{}
+	_ provider.Provider                       = &landbProvider{}
+	_ provider.ProviderWithConfigValidators   = &landbProvider{}
+	_ provider.ProviderWithEphemeralResources = &landbProvider{}
 )
 
 func New(version string) func() provider.Provider {
@@ -32,12 +44,55 @@ func New(version string) func() provider.Provider {
 }
 
 type LandbModel struct {
-	Endpoint     types.String `tfsdk:"endpoint"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	Audience     types.String `tfsdk:"audience"`
+	Endpoint            types.String `tfsdk:"endpoint"`
+	APIVersion          types.String `tfsdk:"api_version"`
+	AuthMethod          types.String `tfsdk:"auth_method"`
+	ClientID            types.String `tfsdk:"client_id"`
+	ClientSecret        types.String `tfsdk:"client_secret"`
+	ClientSecretFile    types.String `tfsdk:"client_secret_file"`
+	ClientSecretCommand types.String `tfsdk:"client_secret_command"`
+	SubjectToken        types.String `tfsdk:"subject_token"`
+	Audience            types.String `tfsdk:"audience"`
+	ClientCertificate   types.String `tfsdk:"client_certificate"`
+	ClientKey           types.String `tfsdk:"client_key"`
+	CABundle            types.String `tfsdk:"ca_bundle"`
+	AccessToken         types.String `tfsdk:"access_token"`
+	ProxyURL            types.String `tfsdk:"proxy_url"`
+	InsecureSkipVerify  types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout      types.String `tfsdk:"request_timeout"`
+	ChangeComment       types.String `tfsdk:"change_comment"`
+	DefaultManager      types.Object `tfsdk:"default_manager"`
+	DefaultResponsible  types.Object `tfsdk:"default_responsible"`
+	DefaultUser         types.Object `tfsdk:"default_user"`
+	DefaultLocation     types.Object `tfsdk:"default_location"`
 }
 
+// providerData is handed to resources on Configure.
+type providerData struct {
+	client   landb.API
+	defaults providerDefaults
+}
+
+// providerDefaults holds the values resources inherit when their own
+// attribute is not configured.
+type providerDefaults struct {
+	Manager     types.Object
+	Responsible types.Object
+	User        types.Object
+	Location    types.Object
+}
+
+const (
+	authMethodClientCredentials = "client_credentials"
+	authMethodKerberos          = "kerberos"
+	authMethodDeviceCode        = "device_code"
+	authMethodTokenExchange     = "token_exchange"
+	authMethodCertificate       = "certificate"
+	authMethodAccessToken       = "access_token"
+)
+
+var authMethods = []string{authMethodClientCredentials, authMethodKerberos, authMethodDeviceCode, authMethodTokenExchange, authMethodCertificate, authMethodAccessToken}
+
 type landbProvider struct {
 	version string
 }
@@ -53,15 +108,146 @@ func (p *landbProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 			"endpoint": schema.StringAttribute{
 				Optional: true,
 			},
+			"api_version": schema.StringAttribute{
+				Description: oneOfDescription("Version of the LanDB API. Defaults to beta. Endpoints the server does not offer in v1 yet keep using beta.", landb.APIVersions),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(landb.APIVersions...),
+				},
+			},
+			"auth_method": schema.StringAttribute{
+				Description: oneOfDescription("How to obtain CERN SSO tokens. Defaults to access_token when access_token is set and to client_credentials otherwise.", authMethods),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(authMethods...),
+				},
+			},
 			"client_id": schema.StringAttribute{
 				Optional: true,
 			},
 			"client_secret": schema.StringAttribute{
-				Optional: true,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"client_secret_file": schema.StringAttribute{
+				Description: "Path to a file containing the client secret",
+				Optional:    true,
+			},
+			"client_secret_command": schema.StringAttribute{
+				Description: "Shell command that prints the client secret",
+				Optional:    true,
+			},
+			"subject_token": schema.StringAttribute{
+				Description: "User token exchanged for a LanDB token when auth_method is token_exchange",
+				Optional:    true,
+				Sensitive:   true,
 			},
 			"audience": schema.StringAttribute{
 				Optional: true,
 			},
+			"client_certificate": schema.StringAttribute{
+				Description: "PEM encoded client certificate, or the path to it, used when auth_method is certificate",
+				Optional:    true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate, or the path to it",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "Pre-minted CERN SSO access token sent as is instead of obtaining one",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				Description: "PEM encoded CA certificates, or the path to them, trusted instead of the system roots by the API and SSO clients",
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "Proxy the API and SSO requests are sent through",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Do not verify server certificates. Only meant for tests",
+				Optional:    true,
+			},
+			"change_comment": schema.StringAttribute{
+				Description: "Reason recorded in the LanDB history of every change, e.g. a ticket number. Resources can override it",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(changeCommentPattern, "must be a single line of printable ASCII characters"),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout of every API and SSO request as a duration, e.g. 30s",
+				Optional:    true,
+			},
+			"default_manager":     providerContactSchema("Manager inherited by devices and virtual machines that do not set one"),
+			"default_responsible": providerContactSchema("Responsible inherited by devices, virtual machines and sets that do not set one"),
+			"default_user":        providerContactSchema("User inherited by devices and virtual machines that do not set one"),
+			"default_location": schema.SingleNestedAttribute{
+				Description: "Location inherited by devices and virtual machines that do not set one",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"building": schema.StringAttribute{Required: true},
+					"floor":    schema.StringAttribute{Required: true},
+					"room":     schema.StringAttribute{Required: true},
+				},
+			},
+		},
+	}
+}
+
+func (p *landbProvider) ConfigValidators(_ context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("client_secret"),
+			path.MatchRoot("client_secret_file"),
+			path.MatchRoot("client_secret_command"),
+		),
+	}
+}
+
+func providerContactSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "One of PERSON, EGROUP, or RESERVED",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(contactTypes...),
+				},
+			},
+			"person": schema.SingleNestedAttribute{
+				Description: "Details if type == PERSON",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"first_name": schema.StringAttribute{Optional: true},
+					"last_name":  schema.StringAttribute{Optional: true},
+					"email":      schema.StringAttribute{Optional: true},
+					"username":   schema.StringAttribute{Optional: true},
+					"department": schema.StringAttribute{Optional: true},
+					"group":      schema.StringAttribute{Optional: true},
+				},
+			},
+			"egroup": schema.SingleNestedAttribute{
+				Description: "Details if type == EGROUP",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name":  schema.StringAttribute{Optional: true},
+					"email": schema.StringAttribute{Optional: true},
+				},
+			},
+			"reserved": schema.SingleNestedAttribute{
+				Description: "Details if type == RESERVED",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"first_name": schema.StringAttribute{Optional: true},
+					"last_name":  schema.StringAttribute{Optional: true},
+				},
+			},
 		},
 	}
 }
@@ -76,8 +262,21 @@ func (p *landbProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	api_version := os.Getenv("LANDB_API_VERSION")
+	auth_method := os.Getenv("LANDB_AUTH_METHOD")
 	client_id := os.Getenv("LANDB_SSO_CLIENT_ID")
 	client_secret := os.Getenv("LANDB_SSO_CLIENT_SECRET")
+	client_secret_file := os.Getenv("LANDB_SSO_CLIENT_SECRET_FILE")
+	client_secret_command := os.Getenv("LANDB_SSO_CLIENT_SECRET_COMMAND")
+	subject_token := os.Getenv("LANDB_SSO_SUBJECT_TOKEN")
+	client_certificate := os.Getenv("LANDB_CLIENT_CERTIFICATE")
+	client_key := os.Getenv("LANDB_CLIENT_KEY")
+	ca_bundle := os.Getenv("LANDB_CA_BUNDLE")
+	access_token := os.Getenv("LANDB_ACCESS_TOKEN")
+	proxy_url := os.Getenv("LANDB_PROXY_URL")
+	insecure_skip_verify := os.Getenv("LANDB_INSECURE_SKIP_VERIFY") == "true"
+	request_timeout := os.Getenv("LANDB_REQUEST_TIMEOUT")
+	change_comment := os.Getenv("LANDB_CHANGE_COMMENT")
 	audience, ok := os.LookupEnv("LANDB_SSO_AUDIENCE")
 	if !ok {
 		audience = "production-microservice-landb-rest"
@@ -87,6 +286,7 @@ func (p *landbProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		endpoint = "https://landb.cern.ch/api/"
 	}
 
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "client_secret", "subject_token", "client_key", "access_token")
 	ctx = tflog.SetField(ctx, "endpoint", endpoint)
 	ctx = tflog.SetField(ctx, "client_id", client_id)
 	ctx = tflog.SetField(ctx, "client_secret", client_secret)
@@ -101,6 +301,24 @@ func (p *landbProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	if config.APIVersion.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_version"),
+			"Invalid LanDB api_version",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the api_version. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_API_VERSION environment variable.",
+		)
+	}
+
+	if config.AuthMethod.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_method"),
+			"Invalid LanDB auth_method",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the auth_method. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_AUTH_METHOD environment variable.",
+		)
+	}
+
 	if config.ClientID.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_id"),
@@ -119,6 +337,33 @@ func (p *landbProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	if config.ClientSecretFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret_file"),
+			"Invalid LanDB API client_secret_file",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the LanDB API client_secret_file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_SSO_CLIENT_SECRET_FILE environment variable.",
+		)
+	}
+
+	if config.ClientSecretCommand.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret_command"),
+			"Invalid LanDB API client_secret_command",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the LanDB API client_secret_command. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_SSO_CLIENT_SECRET_COMMAND environment variable.",
+		)
+	}
+
+	if config.SubjectToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subject_token"),
+			"Invalid CERN SSO subject_token",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the subject_token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_SSO_SUBJECT_TOKEN environment variable.",
+		)
+	}
+
 	if config.Audience.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("audience"),
@@ -128,6 +373,73 @@ func (p *landbProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	if config.ClientCertificate.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_certificate"),
+			"Invalid LanDB client_certificate",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the client_certificate. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_CLIENT_CERTIFICATE environment variable.",
+		)
+	}
+
+	if config.ClientKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_key"),
+			"Invalid LanDB client_key",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the client_key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_CLIENT_KEY environment variable.",
+		)
+	}
+
+	if config.CABundle.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_bundle"),
+			"Invalid LanDB ca_bundle",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the ca_bundle. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_CA_BUNDLE environment variable.",
+		)
+	}
+
+	if config.AccessToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Invalid CERN SSO access_token",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the access_token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_ACCESS_TOKEN environment variable.",
+		)
+	}
+
+	if config.ProxyURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"Invalid LanDB proxy_url",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the proxy_url. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_PROXY_URL environment variable.",
+		)
+	}
+
+	if config.InsecureSkipVerify.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("insecure_skip_verify"),
+			"Invalid LanDB insecure_skip_verify",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the insecure_skip_verify. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_INSECURE_SKIP_VERIFY environment variable.",
+		)
+	}
+
+	if config.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Invalid LanDB request_timeout",
+			"The provider cannot create the LanDB API client as there is an unknown configuration value for the request_timeout. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LANDB_REQUEST_TIMEOUT environment variable.",
+		)
+	}
+
+	resp.Diagnostics.Append(validateContactObject(path.Root("default_manager"), config.DefaultManager)...)
+	resp.Diagnostics.Append(validateContactObject(path.Root("default_responsible"), config.DefaultResponsible)...)
+	resp.Diagnostics.Append(validateContactObject(path.Root("default_user"), config.DefaultUser)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -136,18 +448,140 @@ func (p *landbProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		endpoint = config.Endpoint.ValueString()
 	}
 
+	if !config.APIVersion.IsNull() {
+		api_version = config.APIVersion.ValueString()
+	}
+
+	if api_version == "" {
+		api_version = landb.APIVersionBeta
+	}
+
+	if !config.AuthMethod.IsNull() {
+		auth_method = config.AuthMethod.ValueString()
+	}
+
+	if !config.AccessToken.IsNull() {
+		access_token = config.AccessToken.ValueString()
+	}
+
+	if auth_method == "" && access_token != "" {
+		auth_method = authMethodAccessToken
+	}
+
+	if auth_method == "" {
+		auth_method = authMethodClientCredentials
+	}
+
 	if !config.ClientID.IsNull() {
 		client_id = config.ClientID.ValueString()
 	}
 
+	// A secret source set in the configuration wins over all of the
+	// environment variables, not only over the one of the same source.
+	if !config.ClientSecret.IsNull() || !config.ClientSecretFile.IsNull() || !config.ClientSecretCommand.IsNull() {
+		client_secret, client_secret_file, client_secret_command = "", "", ""
+	}
+
 	if !config.ClientSecret.IsNull() {
 		client_secret = config.ClientSecret.ValueString()
 	}
 
+	if !config.ClientSecretFile.IsNull() {
+		client_secret_file = config.ClientSecretFile.ValueString()
+	}
+
+	if !config.ClientSecretCommand.IsNull() {
+		client_secret_command = config.ClientSecretCommand.ValueString()
+	}
+
+	if client_secret == "" && client_secret_file != "" {
+		secret, err := readSecretFile(client_secret_file)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_secret_file"),
+				"Unable to read CERN SSO client secret",
+				"The provider cannot read the client secret from "+client_secret_file+": "+err.Error(),
+			)
+			return
+		}
+		client_secret = secret
+	}
+
+	if client_secret == "" && client_secret_command != "" {
+		secret, err := runSecretCommand(ctx, client_secret_command)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_secret_command"),
+				"Unable to run CERN SSO client secret command",
+				"The provider cannot obtain the client secret from the configured command: "+err.Error(),
+			)
+			return
+		}
+		client_secret = secret
+	}
+
+	if !config.SubjectToken.IsNull() {
+		subject_token = config.SubjectToken.ValueString()
+	}
+
 	if !config.Audience.IsNull() {
 		audience = config.Audience.ValueString()
 	}
 
+	if !config.ClientCertificate.IsNull() {
+		client_certificate = config.ClientCertificate.ValueString()
+	}
+
+	if !config.ClientKey.IsNull() {
+		client_key = config.ClientKey.ValueString()
+	}
+
+	if !config.CABundle.IsNull() {
+		ca_bundle = config.CABundle.ValueString()
+	}
+
+	if !config.ProxyURL.IsNull() {
+		proxy_url = config.ProxyURL.ValueString()
+	}
+
+	if !config.InsecureSkipVerify.IsNull() {
+		insecure_skip_verify = config.InsecureSkipVerify.ValueBool()
+	}
+
+	if !config.ChangeComment.IsNull() {
+		change_comment = config.ChangeComment.ValueString()
+	}
+
+	if !config.RequestTimeout.IsNull() {
+		request_timeout = config.RequestTimeout.ValueString()
+	}
+
+	var timeout time.Duration
+	if request_timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(request_timeout)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid LanDB request_timeout",
+				"The request_timeout must be a duration such as 30s or 2m: "+err.Error(),
+			)
+		}
+	}
+
+	var caPEM []byte
+	if ca_bundle != "" {
+		var err error
+		caPEM, err = readPEM(ca_bundle)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_bundle"),
+				"Unable to read ca_bundle",
+				"The provider cannot read the ca_bundle: "+err.Error(),
+			)
+		}
+	}
+
 	if endpoint == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("endpoint"),
@@ -158,7 +592,7 @@ func (p *landbProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
-	if client_id == "" {
+	if auth_method != authMethodCertificate && auth_method != authMethodAccessToken && client_id == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_id"),
 			"Missing CERN SSO client ID",
@@ -168,16 +602,85 @@ func (p *landbProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
-	if client_secret == "" {
+	if !slices.Contains(landb.APIVersions, api_version) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_version"),
+			"Invalid LanDB api_version",
+			"The api_version must be one of "+strings.Join(landb.APIVersions, ", ")+", got: "+api_version,
+		)
+	}
+
+	if !slices.Contains(authMethods, auth_method) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_method"),
+			"Invalid LanDB auth_method",
+			"The auth_method must be one of "+strings.Join(authMethods, ", ")+", got: "+auth_method,
+		)
+	}
+
+	if auth_method == authMethodClientCredentials && client_secret == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("client_secret"),
 			"Missing CERN SSO client secret",
 			"The provider cannot fetch a authentication token from the CERN SSO application as there is a missing or empty value for the client_secret. "+
-				"Set the client_secret value in the configuration or use the LANDB_SSO_CLIENT_SECRET environment variable. "+
+				"Set the client_secret, client_secret_file or client_secret_command value in the configuration or use the LANDB_SSO_CLIENT_SECRET, LANDB_SSO_CLIENT_SECRET_FILE or LANDB_SSO_CLIENT_SECRET_COMMAND environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if auth_method == authMethodTokenExchange && subject_token == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subject_token"),
+			"Missing CERN SSO subject token",
+			"The provider cannot exchange a token with the CERN SSO application as there is a missing or empty value for the subject_token. "+
+				"Set the subject_token value in the configuration or use the LANDB_SSO_SUBJECT_TOKEN environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if auth_method == authMethodCertificate && client_certificate == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_certificate"),
+			"Missing LanDB client certificate",
+			"The provider cannot authenticate with a certificate as there is a missing or empty value for the client_certificate. "+
+				"Set the client_certificate value in the configuration or use the LANDB_CLIENT_CERTIFICATE environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if auth_method == authMethodCertificate && client_key == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_key"),
+			"Missing LanDB client key",
+			"The provider cannot authenticate with a certificate as there is a missing or empty value for the client_key. "+
+				"Set the client_key value in the configuration or use the LANDB_CLIENT_KEY environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if auth_method == authMethodAccessToken && access_token == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Missing CERN SSO access token",
+			"The provider cannot authenticate with a pre-minted token as there is a missing or empty value for the access_token. "+
+				"Set the access_token value in the configuration or use the LANDB_ACCESS_TOKEN environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	if auth_method == authMethodAccessToken && access_token != "" {
+		if expiry, err := landb.TokenExpiry(access_token); err != nil {
+			tflog.Warn(ctx, "Unable to read the expiry of the access token", map[string]any{"error": err.Error()})
+		} else if time.Now().After(expiry) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("access_token"),
+				"Expired CERN SSO access token",
+				"The access token expired at "+expiry.Format(time.RFC3339)+". "+
+					"Mint a new token and set it in the access_token value or the LANDB_ACCESS_TOKEN environment variable.",
+			)
+		}
+	}
+
 	if audience == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("audience"),
@@ -196,11 +699,82 @@ func (p *landbProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	ctx = tflog.SetField(ctx, "client_id", client_id)
 	ctx = tflog.SetField(ctx, "client_secret", client_secret)
 	ctx = tflog.SetField(ctx, "audience", audience)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "password")
+	ctx = tflog.SetField(ctx, "auth_method", auth_method)
+	ctx = tflog.SetField(ctx, "api_version", api_version)
 
 	tflog.Debug(ctx, "Creating LanDB client")
 
-	client, err := landb.NewClient(endpoint, client_id, client_secret, audience)
+	var client *landb.Client
+	var err error
+	switch auth_method {
+	case authMethodKerberos:
+		negotiator, nerr := landb.NewCCacheNegotiator()
+		if nerr != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_method"),
+				"Unable to load Kerberos credentials",
+				"The provider cannot authenticate with Kerberos. "+
+					"Ensure a valid ticket is present (kinit) and KRB5CCNAME points at its credential cache.\n\n"+
+					"Kerberos Error: "+nerr.Error(),
+			)
+			return
+		}
+		client, err = landb.NewKerberosClient(endpoint, client_id, audience, negotiator)
+	case authMethodDeviceCode:
+		client, err = landb.NewDeviceCodeClient(endpoint, client_id, audience, func(auth landb.DeviceAuthorization) {
+			promptDeviceLogin(ctx, auth)
+		})
+	case authMethodTokenExchange:
+		client, err = landb.NewTokenExchangeClient(endpoint, client_id, client_secret, subject_token, audience)
+	case authMethodAccessToken:
+		client, err = landb.NewStaticTokenClient(endpoint, access_token)
+	case authMethodCertificate:
+		var certPEM, keyPEM []byte
+		for _, f := range []struct {
+			attribute string
+			value     string
+			pem       *[]byte
+		}{
+			{"client_certificate", client_certificate, &certPEM},
+			{"client_key", client_key, &keyPEM},
+		} {
+			if f.value == "" {
+				continue
+			}
+			b, perr := readPEM(f.value)
+			if perr != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(f.attribute),
+					"Unable to read "+f.attribute,
+					"The provider cannot read the "+f.attribute+": "+perr.Error(),
+				)
+				return
+			}
+			*f.pem = b
+		}
+		client, err = landb.NewCertificateClient(endpoint, certPEM, keyPEM, nil)
+	default:
+		client, err = landb.NewClient(endpoint, client_id, client_secret, audience)
+	}
+	if err == nil {
+		// Set before the device-code login below sends the first request.
+		client.SetUserAgent(fmt.Sprintf("terraform-provider-landb/%s terraform/%s", p.version, req.TerraformVersion))
+		err = client.SetHTTPOptions(landb.HTTPOptions{
+			CABundle:           caPEM,
+			ProxyURL:           proxy_url,
+			InsecureSkipVerify: insecure_skip_verify,
+			Timeout:            timeout,
+		})
+	}
+	if err == nil && auth_method == authMethodDeviceCode {
+		// Log in while configuring so the prompt is not interleaved with
+		// the plan output.
+		_, err = client.WithContext(ctx).Token()
+		if errors.Is(err, landb.ErrTokenNotCached) {
+			tflog.Warn(ctx, "Unable to cache the LanDB access token", map[string]any{"error": err.Error()})
+			err = nil
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create LanDB API Client",
@@ -211,23 +785,122 @@ func (p *landbProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	client.SetDebug(traceLogging())
+	logRequests(client)
+
+	client.SetAPIVersion(api_version)
+	if api_version != landb.APIVersionBeta {
+		versions, err := client.DetectAPIVersions(ctx)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_version"),
+				"Unable to Detect LanDB API Versions",
+				"The provider cannot determine which endpoints the LanDB API offers in "+api_version+". "+
+					"Set api_version to beta to skip the detection.\n\n"+
+					"LanDB Client Error: "+err.Error(),
+			)
+			return
+		}
+		tflog.Debug(ctx, "Detected LanDB API versions", map[string]any{"versions": versions})
+	}
+
+	var api landb.API = client
+	if change_comment != "" {
+		api = client.WithChangeComment(change_comment)
+	}
+
+	resp.DataSourceData = api
+	resp.EphemeralResourceData = api
+	resp.ResourceData = &providerData{
+		client: api,
+		defaults: providerDefaults{
+			Manager:     config.DefaultManager,
+			Responsible: config.DefaultResponsible,
+			User:        config.DefaultUser,
+			Location:    config.DefaultLocation,
+		},
+	}
 
 	tflog.Info(ctx, "Configured LanDB client", map[string]any{"success": true})
 }
 
+// traceLogging reports whether Terraform logs the provider at trace level,
+// the only level at which whole API requests and responses are logged.
+func traceLogging() bool {
+	level := os.Getenv("TF_LOG_PROVIDER")
+	if level == "" {
+		level = os.Getenv("TF_LOG")
+	}
+	return strings.EqualFold(level, "TRACE")
+}
+
+// logRequests logs every API request with its request ID, so errors can be
+// correlated with the LanDB server logs. Requests are logged with their own
+// context, which carries the logger of the operation that sent them.
+func logRequests(client *landb.Client) {
+	client.HTTPClient.OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+		tflog.Debug(r.Request.Context(), "LanDB API request", map[string]any{
+			"request_id": r.Request.Header.Get(landb.RequestIDHeader),
+			"method":     r.Request.Method,
+			"url":        r.Request.URL,
+			"status":     r.StatusCode(),
+		})
+		return nil
+	})
+
+	client.HTTPClient.OnError(func(r *resty.Request, err error) {
+		tflog.Error(r.Context(), "LanDB API request failed", map[string]any{
+			"request_id": r.Header.Get(landb.RequestIDHeader),
+			"method":     r.Method,
+			"url":        r.URL,
+			"error":      err.Error(),
+		})
+	})
+}
+
+// promptDeviceLogin tells the user where to complete a device code login.
+// Terraform does not show provider output, so the prompt is written to the
+// controlling terminal when there is one.
+func promptDeviceLogin(ctx context.Context, auth landb.DeviceAuthorization) {
+	message := fmt.Sprintf("To log in to CERN SSO, open %s and enter the code %s\n", auth.VerificationURI, auth.UserCode)
+
+	tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+	if err != nil {
+		tflog.Warn(ctx, message)
+		return
+	}
+	defer tty.Close()
+
+	fmt.Fprint(tty, message)
+}
+
 func (p *landbProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewDeviceInterfaceResource,
 		NewDeviceResource,
+		NewDNSAliasResource,
 		NewSetAttachmentResource,
 		NewSetResource,
+		NewVirtualMachineResource,
 	}
 }
 
 func (p *landbProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewDeviceDataSource,
+		NewLocationsDataSource,
+		NewManufacturersDataSource,
+		NewModelsDataSource,
+		NewOperatingSystemsDataSource,
+		NewServiceDataSource,
 		NewSetDataSource,
+		NewSubnetDataSource,
+		NewZonesDataSource,
+	}
+}
+
+func (p *landbProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
 	}
 }
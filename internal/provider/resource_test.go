@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb/landbmock"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// configureResource hands r a mock client as the provider would.
+func configureResource(t *testing.T, r resource.Resource) *landbmock.MockAPI {
+	client := landbmock.NewMockAPI(gomock.NewController(t))
+	client.EXPECT().WithContext(gomock.Any()).Return(client).AnyTimes()
+
+	var resp resource.ConfigureResponse
+	r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &providerData{client: client},
+	}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	return client
+}
+
+// newPlan returns a plan of r holding model.
+func newPlan(t *testing.T, r resource.Resource, model any) tfsdk.Plan {
+	s := resourceSchema(t, r)
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+	require.False(t, plan.Set(context.Background(), model).HasError())
+	return plan
+}
+
+// newState returns a state of r holding model, or an empty state when model
+// is nil.
+func newState(t *testing.T, r resource.Resource, model any) tfsdk.State {
+	s := resourceSchema(t, r)
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+	if model != nil {
+		require.False(t, state.Set(context.Background(), model).HasError())
+	}
+	return state
+}
+
+func resourceSchema(t *testing.T, r resource.Resource) schema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	return resp.Schema
+}
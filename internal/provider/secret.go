@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// readSecretFile returns the contents of path without surrounding whitespace.
+func readSecretFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	secret := strings.TrimSpace(string(b))
+	if secret == "" {
+		return "", errors.New("file is empty")
+	}
+	return secret, nil
+}
+
+// runSecretCommand runs command through the shell and returns its standard
+// output without surrounding whitespace. Standard error is only used to
+// explain failures.
+func runSecretCommand(ctx context.Context, command string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	secret := strings.TrimSpace(stdout.String())
+	if secret == "" {
+		return "", errors.New("command printed no secret")
+	}
+	return secret, nil
+}
+
+// readPEM returns value itself when it holds PEM data and otherwise reads
+// the file it names.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN ") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
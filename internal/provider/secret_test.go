@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSecretFile(t *testing.T) {
+	dir := t.TempDir()
+
+	secretPath := filepath.Join(dir, "secret")
+	require.NoError(t, os.WriteFile(secretPath, []byte("  s3cr3t\n"), 0o600))
+	secret, err := readSecretFile(secretPath)
+	require.NoError(t, err)
+	require.Equal(t, "s3cr3t", secret)
+
+	emptyPath := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(emptyPath, []byte("\n"), 0o600))
+	_, err = readSecretFile(emptyPath)
+	require.EqualError(t, err, "file is empty")
+
+	_, err = readSecretFile(filepath.Join(dir, "missing"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestRunSecretCommand(t *testing.T) {
+	secret, err := runSecretCommand(context.Background(), "printf '  s3cr3t\\n\\n'")
+	require.NoError(t, err)
+	require.Equal(t, "s3cr3t", secret)
+
+	_, err = runSecretCommand(context.Background(), "true")
+	require.EqualError(t, err, "command printed no secret")
+
+	_, err = runSecretCommand(context.Background(), "echo vault is sealed >&2; exit 3")
+	require.EqualError(t, err, "exit status 3: vault is sealed")
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type serviceDataSourceModel struct {
+	ID          types.String  `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Building    types.String  `tfsdk:"building"`
+	Outlet      types.String  `tfsdk:"outlet"`
+	Description types.String  `tfsdk:"description"`
+	Subnets     []subnetModel `tfsdk:"subnets"`
+}
+
+type serviceDataSource struct {
+	client landb.API
+}
+
+func NewServiceDataSource() datasource.DataSource {
+	return &serviceDataSource{}
+}
+
+func (d *serviceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (d *serviceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(landb.API); ok {
+		d.client = client
+	}
+}
+
+func (d *serviceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lookup a network service by name, building or outlet",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the service in LANDB",
+			},
+			"building": schema.StringAttribute{
+				Optional:    true,
+				Description: "Building served by the service",
+			},
+			"outlet": schema.StringAttribute{
+				Optional:    true,
+				Description: "Outlet connected to the service",
+			},
+			"description": schema.StringAttribute{Computed: true},
+			"subnets": schema.ListNestedAttribute{
+				Computed:     true,
+				NestedObject: schema.NestedAttributeObject{Attributes: subnetSchemaAttributes()},
+			},
+		},
+	}
+}
+
+func (d *serviceDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("name"),
+			path.MatchRoot("building"),
+			path.MatchRoot("outlet"),
+		),
+	}
+}
+
+func (d *serviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serviceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var service *landb.Service
+	if !data.Name.IsNull() {
+		s, err := d.client.WithContext(ctx).GetService(data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading service", err.Error())
+			return
+		}
+		service = s
+	} else {
+		services, err := d.client.WithContext(ctx).FindServices(data.Building.ValueString(), data.Outlet.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error finding service", err.Error())
+			return
+		}
+		if len(services) != 1 {
+			names := make([]string, 0, len(services))
+			for _, s := range services {
+				names = append(names, s.Name)
+			}
+			resp.Diagnostics.AddError(
+				"Service not unique",
+				fmt.Sprintf("Expected exactly one service, found %d (%s). Look the service up by name or outlet instead.", len(services), strings.Join(names, ", ")),
+			)
+			return
+		}
+		service = &services[0]
+	}
+
+	data.ID = types.StringValue(service.Name)
+	data.Name = types.StringValue(service.Name)
+	data.Description = types.StringValue(service.Description)
+	data.Subnets = make([]subnetModel, 0, len(service.Subnets))
+	for _, s := range service.Subnets {
+		data.Subnets = append(data.Subnets, flattenSubnet(s))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -8,10 +8,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
-	landb "landb/internal/client"
+	"github.com/barnes-c/terraform-provider-landb/landb"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,18 +24,19 @@ import (
 )
 
 type setAttachmentResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	SetName     types.String `tfsdk:"set_name"`
-	DeviceName  types.String `tfsdk:"device_name"`
-	IPv4        types.String `tfsdk:"ipv4"`
-	IPv6        types.String `tfsdk:"ipv6"`
-	Description types.String `tfsdk:"description"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ChangeComment types.String `tfsdk:"change_comment"`
+	ID            types.String `tfsdk:"id"`
+	SetName       types.String `tfsdk:"set_name"`
+	DeviceName    types.String `tfsdk:"device_name"`
+	IPv4          types.String `tfsdk:"ipv4"`
+	IPv6          types.String `tfsdk:"ipv6"`
+	Description   types.String `tfsdk:"description"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
 }
 
 type setAttachmentResource struct {
-	client *landb.Client
+	client landb.API
 }
 
 func NewSetAttachmentResource() resource.Resource {
@@ -47,22 +51,30 @@ func (r *setAttachmentResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = schema.Schema{
 		Description: "Manages a single IP-address attachment on a set",
 		Attributes: map[string]schema.Attribute{
+			"change_comment": changeCommentAttribute(),
 			"id": schema.StringAttribute{
 				Computed:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"set_name": schema.StringAttribute{
-				Required: true,
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"device_name": schema.StringAttribute{
 				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"ipv4": schema.StringAttribute{
-				Required: true,
+				Description:   "IPv4 address to attach. Defaults to the IPv4 address registered on the device, which must then have exactly one. Set to an empty string to attach no IPv4 address.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"ipv6": schema.StringAttribute{
-				Required: true,
+				Description:   "IPv6 address to attach. Defaults to the IPv6 address registered on the device, which must then have exactly one. Set to an empty string to attach no IPv6 address.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"description": schema.StringAttribute{
 				Optional: true,
@@ -78,8 +90,8 @@ func (r *setAttachmentResource) Schema(_ context.Context, _ resource.SchemaReque
 }
 
 func (r *setAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client, ok := req.ProviderData.(*landb.Client); ok {
-		r.client = client
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
 	}
 }
 
@@ -90,6 +102,11 @@ func (r *setAttachmentResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	resp.Diagnostics.Append(r.resolveAddresses(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	att := landb.SetAttachment{
 		DeviceName:  plan.DeviceName.ValueString(),
 		IPv4:        plan.IPv4.ValueString(),
@@ -97,7 +114,7 @@ func (r *setAttachmentResource) Create(ctx context.Context, req resource.CreateR
 		Description: plan.Description.ValueString(),
 	}
 
-	created, err := r.client.CreateSetAttachment(plan.SetName.ValueString(), att)
+	created, err := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment).CreateSetAttachment(plan.SetName.ValueString(), att)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating set attachment", err.Error())
 		return
@@ -117,7 +134,7 @@ func (r *setAttachmentResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	all, err := r.client.GetSetAttachments(state.SetName.ValueString())
+	all, err := r.client.WithContext(ctx).GetSetAttachments(state.SetName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error listing set attachments", err.Error())
 		return
@@ -146,12 +163,21 @@ func (r *setAttachmentResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *setAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if updateChangeCommentOnly(ctx, req, resp) {
+		return
+	}
+
 	var plan setAttachmentResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.resolveAddresses(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	att := landb.SetAttachment{
 		DeviceName:  plan.DeviceName.ValueString(),
 		IPv4:        plan.IPv4.ValueString(),
@@ -159,7 +185,7 @@ func (r *setAttachmentResource) Update(ctx context.Context, req resource.UpdateR
 		Description: plan.Description.ValueString(),
 	}
 
-	updated, err := r.client.UpdateSetAttachment(plan.SetName.ValueString(), plan.ID.ValueString(), att)
+	updated, err := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment).UpdateSetAttachment(plan.SetName.ValueString(), plan.ID.ValueString(), att)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating set attachment", err.Error())
 		return
@@ -176,7 +202,7 @@ func (r *setAttachmentResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err := r.client.DeleteSetAttachment(state.SetName.ValueString(), state.ID.ValueString())
+	err := withChangeComment(r.client.WithContext(ctx), state.ChangeComment).DeleteSetAttachment(state.SetName.ValueString(), state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, landb.ErrDeleteNotSupported) {
 			resp.Diagnostics.AddWarning(
@@ -200,6 +226,70 @@ func (r *setAttachmentResource) Delete(ctx context.Context, req resource.DeleteR
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *setAttachmentResource) resolveAddresses(ctx context.Context, plan *setAttachmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.IPv4.IsUnknown() || plan.IPv6.IsUnknown() {
+		addresses, err := r.client.WithContext(ctx).GetDeviceIPAddresses(plan.DeviceName.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("device_name"),
+				"Error resolving device addresses",
+				fmt.Sprintf("Could not look up the addresses registered on device %q: %s", plan.DeviceName.ValueString(), err),
+			)
+			return diags
+		}
+
+		var ipv4, ipv6 []string
+		for _, a := range addresses {
+			if a.IPv4 != "" && !slices.Contains(ipv4, a.IPv4) {
+				ipv4 = append(ipv4, a.IPv4)
+			}
+			if a.IPv6 != "" && !slices.Contains(ipv6, a.IPv6) {
+				ipv6 = append(ipv6, a.IPv6)
+			}
+		}
+
+		if plan.IPv4.IsUnknown() {
+			plan.IPv4 = r.deviceAddress(&diags, path.Root("ipv4"), plan.DeviceName.ValueString(), ipv4)
+		}
+		if plan.IPv6.IsUnknown() {
+			plan.IPv6 = r.deviceAddress(&diags, path.Root("ipv6"), plan.DeviceName.ValueString(), ipv6)
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if plan.IPv4.ValueString() == "" && plan.IPv6.ValueString() == "" {
+		diags.AddAttributeError(
+			path.Root("device_name"),
+			"No address to attach",
+			fmt.Sprintf("Device %q has no registered IPv4 or IPv6 address. Set ipv4 or ipv6 explicitly.", plan.DeviceName.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// deviceAddress returns the only one of the addresses registered on a device
+// for the attribute at p. The user has to choose when there are several.
+func (r *setAttachmentResource) deviceAddress(diags *diag.Diagnostics, p path.Path, deviceName string, addresses []string) types.String {
+	switch len(addresses) {
+	case 0:
+		return types.StringValue("")
+	case 1:
+		return types.StringValue(addresses[0])
+	}
+
+	diags.AddAttributeError(
+		p,
+		"Ambiguous device address",
+		fmt.Sprintf("Device %q has several registered addresses (%s). Set %s to the one to attach.", deviceName, strings.Join(addresses, ", "), p),
+	)
+	return types.StringUnknown()
+}
+
 func (r *setAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
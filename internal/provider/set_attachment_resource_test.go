@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testSetAttachmentModel() setAttachmentResourceModel {
+	return setAttachmentResourceModel{
+		ChangeComment: types.StringNull(),
+		ID:            types.StringUnknown(),
+		SetName:       types.StringValue("TEST-SET"),
+		DeviceName:    types.StringValue("TEST-DEVICE"),
+		IPv4:          types.StringValue("192.0.2.10"),
+		IPv6:          types.StringValue("2001:db8::10"),
+		Description:   types.StringValue("Test attachment"),
+		CreatedAt:     types.StringUnknown(),
+		UpdatedAt:     types.StringUnknown(),
+	}
+}
+
+func TestSetAttachmentResourceCreate(t *testing.T) {
+	r := NewSetAttachmentResource()
+	client := configureResource(t, r)
+
+	expected := landb.SetAttachment{
+		DeviceName:  "TEST-DEVICE",
+		IPv4:        "192.0.2.10",
+		IPv6:        "2001:db8::10",
+		Description: "Test attachment",
+	}
+	client.EXPECT().CreateSetAttachment("TEST-SET", expected).Return(expected, nil)
+
+	req := resource.CreateRequest{Plan: newPlan(t, r, testSetAttachmentModel())}
+	resp := resource.CreateResponse{State: newState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state setAttachmentResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, "TEST-DEVICE", state.ID.ValueString())
+}
+
+func TestSetAttachmentResourceCreateResolvesAddresses(t *testing.T) {
+	r := NewSetAttachmentResource()
+	client := configureResource(t, r)
+
+	expected := landb.SetAttachment{
+		DeviceName:  "TEST-DEVICE",
+		IPv4:        "192.0.2.20",
+		IPv6:        "2001:db8::10",
+		Description: "Test attachment",
+	}
+	client.EXPECT().GetDeviceIPAddresses("TEST-DEVICE").Return([]landb.IPAddress{{IPv4: "192.0.2.20", IPv6: "2001:db8::20"}}, nil)
+	client.EXPECT().CreateSetAttachment("TEST-SET", expected).Return(expected, nil)
+
+	plan := testSetAttachmentModel()
+	plan.IPv4 = types.StringUnknown()
+
+	req := resource.CreateRequest{Plan: newPlan(t, r, plan)}
+	resp := resource.CreateResponse{State: newState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state setAttachmentResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, "192.0.2.20", state.IPv4.ValueString())
+}
+
+func TestSetAttachmentResourceCreateWithoutAddresses(t *testing.T) {
+	r := NewSetAttachmentResource()
+	client := configureResource(t, r)
+
+	client.EXPECT().GetDeviceIPAddresses("TEST-DEVICE").Return(nil, nil)
+
+	plan := testSetAttachmentModel()
+	plan.IPv4 = types.StringUnknown()
+	plan.IPv6 = types.StringUnknown()
+
+	req := resource.CreateRequest{Plan: newPlan(t, r, plan)}
+	resp := resource.CreateResponse{State: newState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+	require.Equal(t, "No address to attach", resp.Diagnostics[0].Summary())
+	require.True(t, resp.State.Raw.IsNull())
+}
+
+func TestSetAttachmentResourceCreateWithoutIPv6(t *testing.T) {
+	r := NewSetAttachmentResource()
+	client := configureResource(t, r)
+
+	expected := landb.SetAttachment{
+		DeviceName:  "TEST-DEVICE",
+		IPv4:        "192.0.2.20",
+		Description: "Test attachment",
+	}
+	client.EXPECT().GetDeviceIPAddresses("TEST-DEVICE").Return([]landb.IPAddress{{IPv4: "192.0.2.20", IPv6: "2001:db8::20"}}, nil)
+	client.EXPECT().CreateSetAttachment("TEST-SET", expected).Return(expected, nil)
+
+	plan := testSetAttachmentModel()
+	plan.IPv4 = types.StringUnknown()
+	plan.IPv6 = types.StringValue("")
+
+	req := resource.CreateRequest{Plan: newPlan(t, r, plan)}
+	resp := resource.CreateResponse{State: newState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+}
+
+func TestSetAttachmentResourceCreateAmbiguousAddress(t *testing.T) {
+	r := NewSetAttachmentResource()
+	client := configureResource(t, r)
+
+	client.EXPECT().GetDeviceIPAddresses("TEST-DEVICE").Return([]landb.IPAddress{
+		{IPv4: "192.0.2.20", IPv6: "2001:db8::20"},
+		{IPv4: "192.0.2.21", IPv6: "2001:db8::20"},
+	}, nil)
+
+	plan := testSetAttachmentModel()
+	plan.IPv4 = types.StringUnknown()
+	plan.IPv6 = types.StringUnknown()
+
+	req := resource.CreateRequest{Plan: newPlan(t, r, plan)}
+	resp := resource.CreateResponse{State: newState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	require.Equal(t, []string{"ipv4"}, errorPaths(resp.Diagnostics))
+	require.Equal(t, "Ambiguous device address", resp.Diagnostics[0].Summary())
+	require.True(t, resp.State.Raw.IsNull())
+}
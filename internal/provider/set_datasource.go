@@ -8,7 +8,7 @@ import (
 	"context"
 	"time"
 
-	landb "landb/internal/client"
+	"github.com/barnes-c/terraform-provider-landb/landb"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -29,7 +29,7 @@ type setDataSourceModel struct {
 }
 
 type setDataSource struct {
-	client *landb.Client
+	client landb.API
 }
 
 func NewSetDataSource() datasource.DataSource {
@@ -67,7 +67,7 @@ func (d *setDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 }
 
 func (d *setDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if client, ok := req.ProviderData.(*landb.Client); ok {
+	if client, ok := req.ProviderData.(landb.API); ok {
 		d.client = client
 	}
 }
@@ -79,7 +79,7 @@ func (d *setDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	ptr, err := d.client.GetSet(data.Name.ValueString())
+	ptr, err := d.client.WithContext(ctx).GetSet(data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading set", err.Error())
 		return
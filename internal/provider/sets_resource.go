@@ -8,8 +8,9 @@ import (
 	"context"
 	"time"
 
-	landb "landb/internal/client"
+	"github.com/barnes-c/terraform-provider-landb/landb"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,6 +20,7 @@ import (
 )
 
 type setResourceModel struct {
+	ChangeComment        types.String `tfsdk:"change_comment"`
 	ID                   types.String `tfsdk:"id"`
 	Name                 types.String `tfsdk:"name"`
 	Type                 types.String `tfsdk:"type"`
@@ -32,7 +34,8 @@ type setResourceModel struct {
 }
 
 type setResource struct {
-	client *landb.Client
+	client   landb.API
+	defaults providerDefaults
 }
 
 func NewSetResource() resource.Resource {
@@ -47,14 +50,18 @@ func (r *setResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	resp.Schema = schema.Schema{
 		Description: "Manages a set",
 		Attributes: map[string]schema.Attribute{
+			"change_comment": changeCommentAttribute(),
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"name":                  schema.StringAttribute{Required: true},
-			"type":                  schema.StringAttribute{Required: true},
+			"name": schema.StringAttribute{Required: true},
+			"type": schema.StringAttribute{
+				Description: "Type of the set, e.g. INTERDOMAIN.",
+				Required:    true,
+			},
 			"network_domain":        schema.StringAttribute{Required: true},
 			"responsible":           contactSchemaBlock("Responsible entity for the set"),
 			"description":           schema.StringAttribute{Optional: true},
@@ -66,9 +73,55 @@ func (r *setResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 	}
 }
 
+func (r *setResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config setResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateContactObject(path.Root("responsible"), config.Responsible)...)
+}
+
+func (r *setResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config setResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Responsible = withDefault(config.Responsible, plan.Responsible, r.defaults.Responsible)
+
+	var diags diag.Diagnostics
+	plan.Responsible, diags = resolveContactObject(ctx, r.client, path.Root("responsible"), plan.Responsible)
+	resp.Diagnostics.Append(diags...)
+
+	if !req.State.Raw.IsNull() {
+		var state setResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		// Renaming a set changes its ID, so it cannot be carried over from state.
+		if !plan.Name.Equal(state.Name) {
+			plan.ID = types.StringUnknown()
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *setResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if client, ok := req.ProviderData.(*landb.Client); ok {
-		r.client = client
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+		r.defaults = data.defaults
 	}
 }
 
@@ -95,7 +148,7 @@ func (r *setResource) Create(ctx context.Context, req resource.CreateRequest, re
 		Responsible:          responsible,
 	}
 
-	created, err := r.client.CreateSet(setObj)
+	created, err := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment).CreateSet(setObj)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating set", err.Error())
 		return
@@ -116,7 +169,7 @@ func (r *setResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	ptr, err := r.client.GetSet(state.Name.ValueString())
+	ptr, err := r.client.WithContext(ctx).GetSet(state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading set", err.Error())
 		return
@@ -129,8 +182,13 @@ func (r *setResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 }
 
 func (r *setResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan setResourceModel
+	if updateChangeCommentOnly(ctx, req, resp) {
+		return
+	}
+
+	var plan, state setResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -150,12 +208,13 @@ func (r *setResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		Responsible:          responsible,
 	}
 
-	updated, err := r.client.UpdateSet(plan.Name.ValueString(), setObj)
+	updated, err := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment).UpdateSet(state.Name.ValueString(), setObj)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating set", err.Error())
 		return
 	}
 
+	plan.ID = types.StringValue(updated.Name)
 	plan.Version = types.Int64Value(int64(updated.Version))
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -168,7 +227,11 @@ func (r *setResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_ = r.client.DeleteSet(state.Name.ValueString(), int(state.Version.ValueInt64()))
+	err := withChangeComment(r.client.WithContext(ctx), state.ChangeComment).DeleteSet(state.Name.ValueString(), int(state.Version.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting set", err.Error())
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
 
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetResourceCreate(t *testing.T) {
+	r := NewSetResource()
+	client := configureResource(t, r)
+
+	expected := landb.Set{
+		Name:                 "TEST-SET",
+		Type:                 "INTERDOMAIN",
+		NetworkDomain:        "GPN",
+		Description:          "Test set",
+		ProjectURL:           "https://example.cern.ch",
+		ReceiveNotifications: true,
+		Responsible:          testResponsible,
+	}
+	created := expected
+	created.Version = 1
+	client.EXPECT().WithChangeComment("RITM0123456").Return(client)
+	client.EXPECT().CreateSet(expected).Return(created, nil)
+
+	plan := setResourceModel{
+		ChangeComment:        types.StringValue("RITM0123456"),
+		ID:                   types.StringUnknown(),
+		Name:                 types.StringValue("TEST-SET"),
+		Type:                 types.StringValue("INTERDOMAIN"),
+		NetworkDomain:        types.StringValue("GPN"),
+		Responsible:          flattenContactObject(testResponsible),
+		Description:          types.StringValue("Test set"),
+		ProjectURL:           types.StringValue("https://example.cern.ch"),
+		ReceiveNotifications: types.BoolValue(true),
+		Version:              types.Int64Unknown(),
+		LastUpdated:          types.StringUnknown(),
+	}
+
+	req := resource.CreateRequest{Plan: newPlan(t, r, plan)}
+	resp := resource.CreateResponse{State: newState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state setResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, "TEST-SET", state.ID.ValueString())
+	require.Equal(t, int64(1), state.Version.ValueInt64())
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type subnetModel struct {
+	Name              types.String `tfsdk:"name"`
+	Service           types.String `tfsdk:"service"`
+	IPv4Range         types.String `tfsdk:"ipv4_range"`
+	IPv6Range         types.String `tfsdk:"ipv6_range"`
+	IPv4Gateway       types.String `tfsdk:"ipv4_gateway"`
+	IPv6Gateway       types.String `tfsdk:"ipv6_gateway"`
+	FreeIPv4Addresses types.Int64  `tfsdk:"free_ipv4_addresses"`
+	FreeIPv6Addresses types.Int64  `tfsdk:"free_ipv6_addresses"`
+}
+
+type subnetDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Service           types.String `tfsdk:"service"`
+	IPv4Range         types.String `tfsdk:"ipv4_range"`
+	IPv6Range         types.String `tfsdk:"ipv6_range"`
+	IPv4Gateway       types.String `tfsdk:"ipv4_gateway"`
+	IPv6Gateway       types.String `tfsdk:"ipv6_gateway"`
+	FreeIPv4Addresses types.Int64  `tfsdk:"free_ipv4_addresses"`
+	FreeIPv6Addresses types.Int64  `tfsdk:"free_ipv6_addresses"`
+}
+
+type subnetDataSource struct {
+	client landb.API
+}
+
+func NewSubnetDataSource() datasource.DataSource {
+	return &subnetDataSource{}
+}
+
+func (d *subnetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subnet"
+}
+
+func (d *subnetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(landb.API); ok {
+		d.client = client
+	}
+}
+
+func subnetSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name":                schema.StringAttribute{Computed: true},
+		"service":             schema.StringAttribute{Computed: true, Description: "Network service the subnet belongs to"},
+		"ipv4_range":          schema.StringAttribute{Computed: true, Description: "IPv4 range of the subnet in CIDR notation"},
+		"ipv6_range":          schema.StringAttribute{Computed: true, Description: "IPv6 range of the subnet in CIDR notation"},
+		"ipv4_gateway":        schema.StringAttribute{Computed: true},
+		"ipv6_gateway":        schema.StringAttribute{Computed: true},
+		"free_ipv4_addresses": schema.Int64Attribute{Computed: true, Description: "Number of unallocated IPv4 addresses"},
+		"free_ipv6_addresses": schema.Int64Attribute{Computed: true, Description: "Number of unallocated IPv6 addresses"},
+	}
+}
+
+func (d *subnetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := subnetSchemaAttributes()
+	attributes["id"] = schema.StringAttribute{Computed: true}
+	attributes["name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "Name of the subnet in LANDB",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lookup a subnet and its free addresses by name",
+		Attributes:  attributes,
+	}
+}
+
+func flattenSubnet(s landb.Subnet) subnetModel {
+	return subnetModel{
+		Name:              types.StringValue(s.Name),
+		Service:           types.StringValue(s.Service),
+		IPv4Range:         types.StringValue(s.IPv4Range),
+		IPv6Range:         types.StringValue(s.IPv6Range),
+		IPv4Gateway:       types.StringValue(s.IPv4Gateway),
+		IPv6Gateway:       types.StringValue(s.IPv6Gateway),
+		FreeIPv4Addresses: types.Int64Value(s.FreeIPv4Addresses),
+		FreeIPv6Addresses: types.Int64Value(s.FreeIPv6Addresses),
+	}
+}
+
+func (d *subnetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data subnetDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnet, err := d.client.WithContext(ctx).GetSubnet(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading subnet", err.Error())
+		return
+	}
+
+	s := flattenSubnet(*subnet)
+	data.ID = s.Name
+	data.Service = s.Service
+	data.IPv4Range = s.IPv4Range
+	data.IPv6Range = s.IPv6Range
+	data.IPv4Gateway = s.IPv4Gateway
+	data.IPv6Gateway = s.IPv6Gateway
+	data.FreeIPv4Addresses = s.FreeIPv4Addresses
+	data.FreeIPv6Addresses = s.FreeIPv6Addresses
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,582 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type virtualMachineResourceModel struct {
+	ChangeComment        types.String                   `tfsdk:"change_comment"`
+	ID                   types.String                   `tfsdk:"id"`
+	Name                 types.String                   `tfsdk:"name"`
+	Parent               types.String                   `tfsdk:"parent"`
+	Description          types.String                   `tfsdk:"description"`
+	DHCPResponse         types.String                   `tfsdk:"dhcp_response"`
+	IPv4InDNSAndFirewall types.Bool                     `tfsdk:"ipv4_in_dns_and_firewall"`
+	IPv6InDNSAndFirewall types.Bool                     `tfsdk:"ipv6_in_dns_and_firewall"`
+	ManagerLock          types.String                   `tfsdk:"manager_lock"`
+	Ownership            types.String                   `tfsdk:"ownership"`
+	Type                 types.String                   `tfsdk:"type"`
+	Zone                 types.String                   `tfsdk:"zone"`
+	Location             types.Object                   `tfsdk:"location"`
+	OperatingSystem      types.Object                   `tfsdk:"operating_system"`
+	Manager              types.Object                   `tfsdk:"manager"`
+	Responsible          types.Object                   `tfsdk:"responsible"`
+	User                 types.Object                   `tfsdk:"user"`
+	Interfaces           []virtualMachineInterfaceModel `tfsdk:"interfaces"`
+	Version              types.Int64                    `tfsdk:"version"`
+	LastUpdated          types.String                   `tfsdk:"last_updated"`
+}
+
+type virtualMachineInterfaceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Service    types.String `tfsdk:"service"`
+	MACAddress types.String `tfsdk:"mac_address"`
+	IPv4       types.String `tfsdk:"ipv4"`
+	IPv6       types.String `tfsdk:"ipv6"`
+}
+
+type virtualMachineResource struct {
+	client   landb.API
+	defaults providerDefaults
+}
+
+func NewVirtualMachineResource() resource.Resource {
+	return &virtualMachineResource{}
+}
+
+func (r *virtualMachineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_machine"
+}
+
+func (r *virtualMachineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a virtual machine registered under a parent host or cluster. Virtual machines cannot be imported, because LanDB does not list the interfaces of a device.",
+		Attributes: map[string]schema.Attribute{
+			"change_comment": changeCommentAttribute(),
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description:   "Name of the virtual machine.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"parent": schema.StringAttribute{
+				Description: "Name of the host or cluster the virtual machine runs on.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{Optional: true},
+			"dhcp_response": schema.StringAttribute{
+				Description: "DHCP response policy, e.g. ALWAYS.",
+				Required:    true,
+			},
+			"ipv4_in_dns_and_firewall": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"ipv6_in_dns_and_firewall": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"manager_lock": schema.StringAttribute{
+				Description: "Manager lock of the virtual machine, e.g. NO_LOCK.",
+				Required:    true,
+			},
+			"ownership": schema.StringAttribute{
+				Description:   "Ownership of the virtual machine. Defaults to the ownership of the parent.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"type": schema.StringAttribute{
+				Description: "Device type the virtual machine is registered as in LanDB.",
+				Required:    true,
+			},
+			"zone": schema.StringAttribute{
+				Description:   "Zone of the virtual machine. Defaults to the zone of the parent.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"location": schema.SingleNestedAttribute{
+				Description:   "Physical location of the virtual machine. Defaults to the location of the parent.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"building": schema.StringAttribute{Required: true},
+					"floor":    schema.StringAttribute{Required: true},
+					"room":     schema.StringAttribute{Required: true},
+				},
+			},
+			"operating_system": schema.SingleNestedAttribute{
+				Description: "Operating system of the virtual machine",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"family":  schema.StringAttribute{Required: true},
+					"version": schema.StringAttribute{Optional: true},
+				},
+			},
+			"manager":     contactSchemaBlock("Manager of the virtual machine"),
+			"responsible": contactSchemaBlock("Responsible person of the virtual machine"),
+			"user":        contactSchemaBlock("User of the virtual machine"),
+			"interfaces": schema.ListNestedAttribute{
+				Description: "Virtual interfaces of the virtual machine.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the interface.",
+							Required:    true,
+						},
+						"service": schema.StringAttribute{
+							Description: "Network service the addresses are allocated from.",
+							Required:    true,
+						},
+						"mac_address": schema.StringAttribute{
+							Description: "Hardware address of the interface.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(macAddressRegex, "must be a MAC address such as 02-00-00-00-00-01"),
+							},
+						},
+						"ipv4": schema.StringAttribute{
+							Description: "Requested IPv4 address. Allocated from the service when not set.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"ipv6": schema.StringAttribute{
+							Description: "Requested IPv6 address. Allocated from the service when not set.",
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"version":      schema.Int64Attribute{Computed: true},
+			"last_updated": schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (r *virtualMachineResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config virtualMachineResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateContactObject(path.Root("manager"), config.Manager)...)
+	resp.Diagnostics.Append(validateContactObject(path.Root("responsible"), config.Responsible)...)
+	resp.Diagnostics.Append(validateContactObject(path.Root("user"), config.User)...)
+
+	seen := map[string]bool{}
+	for i, iface := range config.Interfaces {
+		if iface.Name.IsUnknown() || iface.Name.IsNull() {
+			continue
+		}
+		if seen[iface.Name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("interfaces").AtListIndex(i).AtName("name"),
+				"Duplicate interface name",
+				fmt.Sprintf("The interface %q is declared more than once.", iface.Name.ValueString()),
+			)
+		}
+		seen[iface.Name.ValueString()] = true
+	}
+}
+
+func (r *virtualMachineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config virtualMachineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Location = withDefault(config.Location, plan.Location, r.defaults.Location)
+	plan.Manager = withDefault(config.Manager, plan.Manager, r.defaults.Manager)
+	plan.Responsible = withDefault(config.Responsible, plan.Responsible, r.defaults.Responsible)
+	plan.User = withDefault(config.User, plan.User, r.defaults.User)
+
+	var diags diag.Diagnostics
+	plan.Manager, diags = resolveContactObject(ctx, r.client, path.Root("manager"), plan.Manager)
+	resp.Diagnostics.Append(diags...)
+	plan.Responsible, diags = resolveContactObject(ctx, r.client, path.Root("responsible"), plan.Responsible)
+	resp.Diagnostics.Append(diags...)
+	plan.User, diags = resolveContactObject(ctx, r.client, path.Root("user"), plan.User)
+	resp.Diagnostics.Append(diags...)
+
+	if r.client != nil && !plan.Parent.IsUnknown() {
+		parent, err := r.client.WithContext(ctx).GetDevice(plan.Parent.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parent"),
+				"Parent device not found",
+				fmt.Sprintf("The parent %q of the virtual machine could not be read: %s", plan.Parent.ValueString(), err),
+			)
+			return
+		}
+
+		if plan.Zone.IsUnknown() {
+			plan.Zone = types.StringValue(parent.Zone)
+		}
+		if plan.Ownership.IsUnknown() {
+			plan.Ownership = types.StringValue(parent.Ownership)
+		}
+		if plan.Location.IsUnknown() {
+			plan.Location = flattenLocation(parent.Location)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state virtualMachineResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		keepInterfaceAddresses(plan.Interfaces, state.Interfaces)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// keepInterfaceAddresses copies the allocated addresses of an interface from
+// state into the plan when the interface of the same name keeps its service.
+// The list index cannot be used for this because removing an interface
+// shifts the ones after it.
+func keepInterfaceAddresses(planned, current []virtualMachineInterfaceModel) {
+	byName := make(map[string]virtualMachineInterfaceModel, len(current))
+	for _, iface := range current {
+		byName[iface.Name.ValueString()] = iface
+	}
+
+	for i, iface := range planned {
+		prior, ok := byName[iface.Name.ValueString()]
+		if !ok || !iface.Service.Equal(prior.Service) {
+			continue
+		}
+		if iface.IPv4.IsUnknown() {
+			planned[i].IPv4 = prior.IPv4
+		}
+		if iface.IPv6.IsUnknown() {
+			planned[i].IPv6 = prior.IPv6
+		}
+	}
+}
+
+func (r *virtualMachineResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data, ok := req.ProviderData.(*providerData); ok {
+		r.client = data.client
+		r.defaults = data.defaults
+	}
+}
+
+func (r *virtualMachineResource) expandDevice(ctx context.Context, plan virtualMachineResourceModel) (landb.Device, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	location, d := expandLocation(ctx, plan.Location)
+	diags.Append(d...)
+	os, d := expandOperatingSystem(ctx, plan.OperatingSystem)
+	diags.Append(d...)
+	manager, d := expandContactObject(ctx, plan.Manager)
+	diags.Append(d...)
+	responsible, d := expandContactObject(ctx, plan.Responsible)
+	diags.Append(d...)
+	user, d := expandContactObject(ctx, plan.User)
+	diags.Append(d...)
+
+	return landb.Device{
+		Name:                 plan.Name.ValueString(),
+		Parent:               plan.Parent.ValueString(),
+		Type:                 plan.Type.ValueString(),
+		Description:          plan.Description.ValueString(),
+		DHCPResponse:         plan.DHCPResponse.ValueString(),
+		IPv4InDNSAndFirewall: plan.IPv4InDNSAndFirewall.ValueBool(),
+		IPv6InDNSAndFirewall: plan.IPv6InDNSAndFirewall.ValueBool(),
+		ManagerLock:          plan.ManagerLock.ValueString(),
+		Ownership:            plan.Ownership.ValueString(),
+		Zone:                 plan.Zone.ValueString(),
+		Location:             location,
+		OperatingSystem:      os,
+		Manager:              manager,
+		Responsible:          responsible,
+		User:                 user,
+	}, diags
+}
+
+// syncInterfaces creates, updates and deletes the interfaces of the virtual
+// machine so that they match the plan, filling in the allocated addresses.
+// On error it returns the interfaces that exist at that point along with the
+// error, so that they can be saved to state.
+func (r *virtualMachineResource) syncInterfaces(client landb.API, deviceName string, planned, current []virtualMachineInterfaceModel) ([]virtualMachineInterfaceModel, error) {
+	existing := map[string]bool{}
+	for _, iface := range current {
+		existing[iface.Name.ValueString()] = true
+	}
+
+	result := make([]virtualMachineInterfaceModel, 0, len(planned))
+	wanted := map[string]bool{}
+	handled := map[string]bool{}
+	deleted := map[string]bool{}
+
+	// partial returns the interfaces applied so far followed by the ones
+	// not touched yet.
+	partial := func(err error) ([]virtualMachineInterfaceModel, error) {
+		for _, iface := range current {
+			name := iface.Name.ValueString()
+			if !handled[name] && !deleted[name] {
+				result = append(result, iface)
+			}
+		}
+		return result, err
+	}
+
+	for _, iface := range planned {
+		name := iface.Name.ValueString()
+		wanted[name] = true
+
+		body := landb.Interface{
+			Name:       name,
+			Service:    iface.Service.ValueString(),
+			MACAddress: iface.MACAddress.ValueString(),
+			IPv4:       iface.IPv4.ValueString(),
+			IPv6:       iface.IPv6.ValueString(),
+		}
+
+		var applied landb.Interface
+		if existing[name] {
+			updated, err := client.UpdateInterface(deviceName, name, body)
+			if err != nil {
+				return partial(fmt.Errorf("interface %q: %w", name, err))
+			}
+			applied = *updated
+		} else {
+			created, err := client.CreateInterface(deviceName, body)
+			if err != nil {
+				return partial(fmt.Errorf("interface %q: %w", name, err))
+			}
+			applied = created
+		}
+
+		iface.IPv4 = types.StringValue(applied.IPv4)
+		iface.IPv6 = types.StringValue(applied.IPv6)
+		result = append(result, iface)
+		handled[name] = true
+	}
+
+	for name := range existing {
+		if wanted[name] {
+			continue
+		}
+		iface, err := client.GetInterface(deviceName, name)
+		if err != nil {
+			return partial(fmt.Errorf("interface %q: %w", name, err))
+		}
+		if err := client.DeleteInterface(deviceName, name, iface.Version); err != nil {
+			return partial(fmt.Errorf("interface %q: %w", name, err))
+		}
+		deleted[name] = true
+	}
+
+	if planned == nil {
+		return nil, nil
+	}
+	return result, nil
+}
+
+func (r *virtualMachineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan virtualMachineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment)
+
+	device, diags := r.expandDevice(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := client.CreateDevice(device)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating virtual machine", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(created.Name)
+	plan.Version = types.Int64Value(int64(created.Version))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.Location = flattenLocation(created.Location)
+	plan.Manager = flattenContactObject(created.Manager)
+	plan.Responsible = flattenContactObject(created.Responsible)
+	plan.User = flattenContactObject(created.User)
+
+	// The device and the interfaces created before a failure are saved so
+	// that they are not left behind untracked.
+	interfaces, err := r.syncInterfaces(client, created.Name, plan.Interfaces, nil)
+	plan.Interfaces = interfaces
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating virtual machine interfaces", err.Error())
+	}
+}
+
+func (r *virtualMachineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state virtualMachineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device, err := r.client.WithContext(ctx).GetDevice(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading virtual machine", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(device.Name)
+	state.Parent = types.StringValue(device.Parent)
+	state.Zone = types.StringValue(device.Zone)
+	state.Ownership = types.StringValue(device.Ownership)
+	state.Type = types.StringValue(device.Type)
+	state.Version = types.Int64Value(int64(device.Version))
+	state.Location = flattenLocation(device.Location)
+	state.Manager = flattenContactObject(device.Manager)
+	state.Responsible = flattenContactObject(device.Responsible)
+	state.User = flattenContactObject(device.User)
+
+	interfaces := state.Interfaces[:0]
+	for _, iface := range state.Interfaces {
+		current, err := r.client.WithContext(ctx).GetInterface(device.Name, iface.Name.ValueString())
+		if errors.Is(err, landb.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading virtual machine interface", err.Error())
+			return
+		}
+		iface.Service = types.StringValue(current.Service)
+		iface.MACAddress = stringOrNull(current.MACAddress)
+		iface.IPv4 = types.StringValue(current.IPv4)
+		iface.IPv6 = types.StringValue(current.IPv6)
+		interfaces = append(interfaces, iface)
+	}
+	state.Interfaces = interfaces
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *virtualMachineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if updateChangeCommentOnly(ctx, req, resp) {
+		return
+	}
+
+	var plan, state virtualMachineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := withChangeComment(r.client.WithContext(ctx), plan.ChangeComment)
+
+	device, diags := r.expandDevice(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := client.UpdateDevice(state.ID.ValueString(), device)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating virtual machine", err.Error())
+		return
+	}
+
+	interfaces, err := r.syncInterfaces(client, updated.Name, plan.Interfaces, state.Interfaces)
+	plan.Interfaces = interfaces
+	plan.Version = types.Int64Value(int64(updated.Version))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating virtual machine interfaces", err.Error())
+	}
+}
+
+func (r *virtualMachineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state virtualMachineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := withChangeComment(r.client.WithContext(ctx), state.ChangeComment)
+
+	remaining, err := r.syncInterfaces(client, state.ID.ValueString(), nil, state.Interfaces)
+	if err != nil {
+		state.Interfaces = remaining
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.AddError("Error deleting virtual machine interfaces", err.Error())
+		return
+	}
+
+	// Removing the interfaces bumps the device version.
+	device, err := r.client.WithContext(ctx).GetDevice(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading virtual machine", err.Error())
+		return
+	}
+
+	err = client.DeleteDevice(device.Name, device.Version)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting virtual machine", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState refuses imports: LanDB cannot list the interfaces of a device,
+// so an imported virtual machine would plan to recreate the interfaces it
+// already has.
+func (r *virtualMachineResource) ImportState(_ context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddError(
+		"Import not supported",
+		"LanDB does not list the interfaces of a device, so virtual machines cannot be imported. "+
+			"Remove the virtual machine from LanDB and create it with Terraform instead.",
+	)
+}
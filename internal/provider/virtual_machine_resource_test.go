@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testVirtualMachineModel(interfaces ...virtualMachineInterfaceModel) virtualMachineResourceModel {
+	return virtualMachineResourceModel{
+		ChangeComment:        types.StringNull(),
+		ID:                   types.StringUnknown(),
+		Name:                 types.StringValue("TEST-VM"),
+		Parent:               types.StringValue("TEST-HOST"),
+		Description:          types.StringValue("Test virtual machine"),
+		DHCPResponse:         types.StringValue("ALWAYS"),
+		IPv4InDNSAndFirewall: types.BoolValue(true),
+		IPv6InDNSAndFirewall: types.BoolValue(false),
+		ManagerLock:          types.StringValue("NO_LOCK"),
+		Ownership:            types.StringValue("CERN"),
+		Type:                 types.StringValue("VIRTUAL_MACHINE"),
+		Zone:                 types.StringValue("0031"),
+		Location:             flattenLocation(landb.Location{Building: "0031", Floor: "S", Room: "028"}),
+		OperatingSystem:      flattenOperatingSystem(landb.OperatingSystem{Family: "LINUX", Version: "RHEL9"}),
+		Manager:              flattenContactObject(testResponsible),
+		Responsible:          flattenContactObject(testResponsible),
+		User:                 flattenContactObject(testResponsible),
+		Interfaces:           interfaces,
+		Version:              types.Int64Unknown(),
+		LastUpdated:          types.StringUnknown(),
+	}
+}
+
+func testVirtualMachine() landb.Device {
+	return landb.Device{
+		Name:                 "TEST-VM",
+		Parent:               "TEST-HOST",
+		Type:                 "VIRTUAL_MACHINE",
+		Description:          "Test virtual machine",
+		DHCPResponse:         "ALWAYS",
+		IPv4InDNSAndFirewall: true,
+		ManagerLock:          "NO_LOCK",
+		Ownership:            "CERN",
+		Zone:                 "0031",
+		Location:             landb.Location{Building: "0031", Floor: "S", Room: "028"},
+		OperatingSystem:      landb.OperatingSystem{Family: "LINUX", Version: "RHEL9"},
+		Manager:              testResponsible,
+		Responsible:          testResponsible,
+		User:                 testResponsible,
+	}
+}
+
+// testVMInterface returns an interface of the test virtual machine. Empty
+// addresses are left unknown, as in a plan.
+func testVMInterface(name, ipv4 string) virtualMachineInterfaceModel {
+	iface := virtualMachineInterfaceModel{
+		Name:       types.StringValue(name),
+		Service:    types.StringValue("S513-C-VM"),
+		MACAddress: types.StringNull(),
+		IPv4:       types.StringUnknown(),
+		IPv6:       types.StringUnknown(),
+	}
+	if ipv4 != "" {
+		iface.IPv4 = types.StringValue(ipv4)
+		iface.IPv6 = types.StringValue("")
+	}
+	return iface
+}
+
+func testVMPriorState(interfaces ...virtualMachineInterfaceModel) virtualMachineResourceModel {
+	prior := testVirtualMachineModel(interfaces...)
+	prior.ID = types.StringValue("TEST-VM")
+	prior.Version = types.Int64Value(2)
+	prior.LastUpdated = types.StringValue("")
+	return prior
+}
+
+func TestVirtualMachineResourceCreate(t *testing.T) {
+	r := NewVirtualMachineResource()
+	client := configureResource(t, r)
+
+	created := testVirtualMachine()
+	created.Version = 1
+	client.EXPECT().CreateDevice(testVirtualMachine()).Return(created, nil)
+	client.EXPECT().CreateInterface("TEST-VM", landb.Interface{Name: "TEST-VM.CERN.CH", Service: "S513-C-VM"}).
+		Return(landb.Interface{Name: "TEST-VM.CERN.CH", IPv4: "10.0.0.1"}, nil)
+	client.EXPECT().CreateInterface("TEST-VM", landb.Interface{Name: "TEST-VM-2.CERN.CH", Service: "S513-C-VM"}).
+		Return(landb.Interface{Name: "TEST-VM-2.CERN.CH", IPv4: "10.0.0.2"}, nil)
+
+	model := testVirtualMachineModel(testVMInterface("TEST-VM.CERN.CH", ""), testVMInterface("TEST-VM-2.CERN.CH", ""))
+	req := resource.CreateRequest{Plan: newPlan(t, r, model)}
+	resp := resource.CreateResponse{State: newState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state virtualMachineResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, "TEST-VM", state.ID.ValueString())
+	require.Equal(t, []virtualMachineInterfaceModel{
+		testVMInterface("TEST-VM.CERN.CH", "10.0.0.1"),
+		testVMInterface("TEST-VM-2.CERN.CH", "10.0.0.2"),
+	}, state.Interfaces)
+}
+
+func TestVirtualMachineResourceCreatePartialFailure(t *testing.T) {
+	r := NewVirtualMachineResource()
+	client := configureResource(t, r)
+
+	created := testVirtualMachine()
+	created.Version = 1
+	client.EXPECT().CreateDevice(testVirtualMachine()).Return(created, nil)
+	client.EXPECT().CreateInterface("TEST-VM", landb.Interface{Name: "TEST-VM.CERN.CH", Service: "S513-C-VM"}).
+		Return(landb.Interface{Name: "TEST-VM.CERN.CH", IPv4: "10.0.0.1"}, nil)
+	client.EXPECT().CreateInterface("TEST-VM", landb.Interface{Name: "TEST-VM-2.CERN.CH", Service: "S513-C-VM"}).
+		Return(landb.Interface{}, errors.New("create interface failed: service full"))
+
+	model := testVirtualMachineModel(testVMInterface("TEST-VM.CERN.CH", ""), testVMInterface("TEST-VM-2.CERN.CH", ""))
+	req := resource.CreateRequest{Plan: newPlan(t, r, model)}
+	resp := resource.CreateResponse{State: newState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+
+	var state virtualMachineResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, "TEST-VM", state.ID.ValueString())
+	require.Equal(t, []virtualMachineInterfaceModel{testVMInterface("TEST-VM.CERN.CH", "10.0.0.1")}, state.Interfaces)
+}
+
+func TestVirtualMachineResourceUpdate(t *testing.T) {
+	r := NewVirtualMachineResource()
+	client := configureResource(t, r)
+
+	updated := testVirtualMachine()
+	updated.Version = 3
+	client.EXPECT().UpdateDevice("TEST-VM", testVirtualMachine()).Return(&updated, nil)
+	client.EXPECT().UpdateInterface("TEST-VM", "TEST-VM-2.CERN.CH", landb.Interface{Name: "TEST-VM-2.CERN.CH", Service: "S513-C-VM", IPv4: "10.0.0.2"}).
+		Return(&landb.Interface{Name: "TEST-VM-2.CERN.CH", IPv4: "10.0.0.2"}, nil)
+	client.EXPECT().CreateInterface("TEST-VM", landb.Interface{Name: "TEST-VM-3.CERN.CH", Service: "S513-C-VM"}).
+		Return(landb.Interface{Name: "TEST-VM-3.CERN.CH", IPv4: "10.0.0.3"}, nil)
+	client.EXPECT().GetInterface("TEST-VM", "TEST-VM.CERN.CH").Return(&landb.Interface{Name: "TEST-VM.CERN.CH", Version: 5}, nil)
+	client.EXPECT().DeleteInterface("TEST-VM", "TEST-VM.CERN.CH", 5).Return(nil)
+
+	prior := testVMPriorState(testVMInterface("TEST-VM.CERN.CH", "10.0.0.1"), testVMInterface("TEST-VM-2.CERN.CH", "10.0.0.2"))
+	req := resource.UpdateRequest{
+		Plan:  newPlan(t, r, testVirtualMachineModel(testVMInterface("TEST-VM-2.CERN.CH", "10.0.0.2"), testVMInterface("TEST-VM-3.CERN.CH", ""))),
+		State: newState(t, r, prior),
+	}
+	resp := resource.UpdateResponse{State: newState(t, r, prior)}
+	r.Update(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state virtualMachineResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, int64(3), state.Version.ValueInt64())
+	require.Equal(t, []virtualMachineInterfaceModel{
+		testVMInterface("TEST-VM-2.CERN.CH", "10.0.0.2"),
+		testVMInterface("TEST-VM-3.CERN.CH", "10.0.0.3"),
+	}, state.Interfaces)
+}
+
+func TestVirtualMachineResourceUpdatePartialFailure(t *testing.T) {
+	r := NewVirtualMachineResource()
+	client := configureResource(t, r)
+
+	updated := testVirtualMachine()
+	updated.Version = 3
+	client.EXPECT().UpdateDevice("TEST-VM", testVirtualMachine()).Return(&updated, nil)
+	client.EXPECT().UpdateInterface("TEST-VM", "TEST-VM-2.CERN.CH", landb.Interface{Name: "TEST-VM-2.CERN.CH", Service: "S513-C-VM", IPv4: "10.0.0.2"}).
+		Return(&landb.Interface{Name: "TEST-VM-2.CERN.CH", IPv4: "10.0.0.2"}, nil)
+	client.EXPECT().GetInterface("TEST-VM", "TEST-VM.CERN.CH").Return(&landb.Interface{Name: "TEST-VM.CERN.CH", Version: 5}, nil)
+	client.EXPECT().DeleteInterface("TEST-VM", "TEST-VM.CERN.CH", 5).Return(errors.New("delete interface failed: locked"))
+
+	prior := testVMPriorState(testVMInterface("TEST-VM.CERN.CH", "10.0.0.1"), testVMInterface("TEST-VM-2.CERN.CH", "10.0.0.2"))
+	req := resource.UpdateRequest{
+		Plan:  newPlan(t, r, testVirtualMachineModel(testVMInterface("TEST-VM-2.CERN.CH", "10.0.0.2"))),
+		State: newState(t, r, prior),
+	}
+	resp := resource.UpdateResponse{State: newState(t, r, prior)}
+	r.Update(context.Background(), req, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+
+	var state virtualMachineResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, []virtualMachineInterfaceModel{
+		testVMInterface("TEST-VM-2.CERN.CH", "10.0.0.2"),
+		testVMInterface("TEST-VM.CERN.CH", "10.0.0.1"),
+	}, state.Interfaces)
+}
+
+func TestVirtualMachineResourceReadInterfaceNotFound(t *testing.T) {
+	r := NewVirtualMachineResource()
+	client := configureResource(t, r)
+
+	device := testVirtualMachine()
+	device.Version = 2
+	client.EXPECT().GetDevice("TEST-VM").Return(&device, nil)
+	client.EXPECT().GetInterface("TEST-VM", "TEST-VM.CERN.CH").
+		Return(nil, fmt.Errorf("get interface failed: %w", landb.ErrNotFound))
+	client.EXPECT().GetInterface("TEST-VM", "TEST-VM-2.CERN.CH").
+		Return(&landb.Interface{Name: "TEST-VM-2.CERN.CH", Service: "S513-C-VM", IPv4: "10.0.0.2"}, nil)
+
+	prior := testVMPriorState(testVMInterface("TEST-VM.CERN.CH", "10.0.0.1"), testVMInterface("TEST-VM-2.CERN.CH", "10.0.0.2"))
+	req := resource.ReadRequest{State: newState(t, r, prior)}
+	resp := resource.ReadResponse{State: newState(t, r, prior)}
+	r.Read(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state virtualMachineResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, []virtualMachineInterfaceModel{testVMInterface("TEST-VM-2.CERN.CH", "10.0.0.2")}, state.Interfaces)
+}
+
+func TestVirtualMachineResourceImportState(t *testing.T) {
+	r := NewVirtualMachineResource().(resource.ResourceWithImportState)
+
+	req := resource.ImportStateRequest{ID: "TEST-VM"}
+	resp := resource.ImportStateResponse{State: newState(t, r, nil)}
+	r.ImportState(context.Background(), req, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+	require.Equal(t, "Import not supported", resp.Diagnostics[0].Summary())
+}
+
+func TestKeepInterfaceAddresses(t *testing.T) {
+	moved := testVMInterface("TEST-VM-3.CERN.CH", "")
+	moved.Service = types.StringValue("S513-C-VM-2")
+
+	planned := []virtualMachineInterfaceModel{
+		testVMInterface("TEST-VM-2.CERN.CH", ""),
+		moved,
+		testVMInterface("TEST-VM-4.CERN.CH", ""),
+	}
+	current := []virtualMachineInterfaceModel{
+		testVMInterface("TEST-VM.CERN.CH", "10.0.0.1"),
+		testVMInterface("TEST-VM-2.CERN.CH", "10.0.0.2"),
+		testVMInterface("TEST-VM-3.CERN.CH", "10.0.0.3"),
+	}
+
+	keepInterfaceAddresses(planned, current)
+	require.Equal(t, []virtualMachineInterfaceModel{
+		testVMInterface("TEST-VM-2.CERN.CH", "10.0.0.2"),
+		moved,
+		testVMInterface("TEST-VM-4.CERN.CH", ""),
+	}, planned)
+}
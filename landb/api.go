@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import "context"
+
+// API is the set of LanDB operations implemented by Client. Depend on it
+// instead of *Client to substitute a fake in tests.
+type API interface {
+	// Token returns an access token for the LanDB API.
+	Token() (*AuthResponse, error)
+	// WithChangeComment returns an API that records comment in the LanDB
+	// history of every object it creates, updates or deletes.
+	WithChangeComment(comment string) API
+	// WithContext returns an API that sends its requests with ctx.
+	WithContext(ctx context.Context) API
+
+	CreateDevice(device Device) (Device, error)
+	GetDevice(name string) (*Device, error)
+	UpdateDevice(name string, device Device) (*Device, error)
+	DeleteDevice(name string, version int) error
+	GetDeviceIPAddresses(name string) ([]IPAddress, error)
+
+	CreateInterface(deviceName string, iface Interface) (Interface, error)
+	GetInterface(deviceName, name string) (*Interface, error)
+	UpdateInterface(deviceName, name string, iface Interface) (*Interface, error)
+	DeleteInterface(deviceName, name string, version int) error
+
+	CreateDNSAlias(alias DNSAlias) (DNSAlias, error)
+	GetDNSAlias(name string) (*DNSAlias, error)
+	UpdateDNSAlias(name string, alias DNSAlias) (*DNSAlias, error)
+	DeleteDNSAlias(name string, version int) error
+
+	CreateSet(set Set) (Set, error)
+	GetSet(name string) (*Set, error)
+	UpdateSet(name string, set Set) (*Set, error)
+	DeleteSet(name string, version int) error
+
+	GetSetAttachments(setName string) ([]SetAttachment, error)
+	CreateSetAttachment(setName string, att SetAttachment) (SetAttachment, error)
+	UpdateSetAttachment(setName, attachmentName string, att SetAttachment) (*SetAttachment, error)
+	DeleteSetAttachment(setName, attachmentName string) error
+
+	GetPerson(username string) (*Person, error)
+	GetEGroup(name string) (*EGroup, error)
+
+	ListLocations(building string) ([]Location, error)
+	ListZones() ([]Zone, error)
+	ListOperatingSystems(family string) ([]OperatingSystem, error)
+	ListManufacturers() ([]Manufacturer, error)
+	ListModels(manufacturer string) ([]Model, error)
+
+	GetService(name string) (*Service, error)
+	FindServices(building, outlet string) ([]Service, error)
+	GetSubnet(name string) (*Subnet, error)
+}
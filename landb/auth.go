@@ -5,6 +5,7 @@
 package landb
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-resty/resty/v2"
@@ -22,12 +23,34 @@ type AuthResponse struct {
 	Scope            string `json:"scope"`
 }
 
+// TokenSource obtains access tokens for a Client. Token sends its SSO
+// requests with client, which shares the transport of the API requests, and
+// gives up once ctx is done.
+type TokenSource interface {
+	Token(ctx context.Context, client *resty.Client) (*AuthResponse, error)
+}
+
+// ClientCredentialsSource obtains tokens with the secret of an SSO
+// application.
+type ClientCredentialsSource struct {
+	ClientID     string
+	ClientSecret string
+	Audience     string
+}
+
+func (s ClientCredentialsSource) Token(ctx context.Context, client *resty.Client) (*AuthResponse, error) {
+	return authenticateClientCredentials(ctx, client, s.ClientID, s.ClientSecret, s.Audience)
+}
+
 func Authenticate(clientID, clientSecret, audience string) (*AuthResponse, error) {
-	client := resty.New()
+	return authenticateClientCredentials(context.Background(), resty.New(), clientID, clientSecret, audience)
+}
 
+func authenticateClientCredentials(ctx context.Context, client *resty.Client, clientID, clientSecret, audience string) (*AuthResponse, error) {
 	var authResp AuthResponse
 
 	resp, err := client.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/x-www-form-urlencoded").
 		SetFormData(map[string]string{
 			"grant_type":    "client_credentials",
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Client talks to the LanDB REST API. Create one with New or one of the
+// NewXxxClient helpers; it is safe for concurrent use.
+type Client struct {
+	HTTPClient *resty.Client
+	baseURL    string
+	// authClient sends the SSO requests of tokenSource over the transport
+	// of HTTPClient.
+	authClient    *resty.Client
+	tokenSource   TokenSource
+	changeComment string
+	// ctx is the context of the requests, see WithContext.
+	ctx context.Context
+	// apiVersion is the version of the endpoints missing from versions.
+	apiVersion string
+	versions   map[string]string
+}
+
+var _ API = &Client{}
+
+// ErrDeleteNotSupported is returned for objects LanDB cannot delete.
+var ErrDeleteNotSupported = errors.New("delete operation not supported by API")
+
+// ErrNotFound is returned when the requested object does not exist.
+var ErrNotFound = errors.New("not found")
+
+// RequestIDHeader carries the ID generated for every request, so failures
+// can be correlated with the LanDB server logs.
+const RequestIDHeader = "X-Request-ID"
+
+// ChangeCommentHeader carries the reason for a change, recorded in the
+// LanDB history of the modified object.
+const ChangeCommentHeader = "X-Change-Comment"
+
+const defaultUserAgent = "terraform-provider-landb"
+
+// NewClient creates a client that authenticates with the secret of an SSO
+// application.
+func NewClient(apiURL, clientID, clientSecret, audience string) (*Client, error) {
+	return NewClientWithTokenSource(apiURL, ClientCredentialsSource{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Audience:     audience,
+	})
+}
+
+// NewKerberosClient creates a client that authenticates with the Kerberos
+// tickets available to negotiator instead of a client secret.
+func NewKerberosClient(apiURL, clientID, audience string, negotiator Negotiator) (*Client, error) {
+	return NewClientWithTokenSource(apiURL, &KerberosSource{
+		Negotiator: negotiator,
+		ClientID:   clientID,
+		Audience:   audience,
+	})
+}
+
+// NewDeviceCodeClient creates a client that logs the user in with the OAuth
+// 2.0 device authorization grant. Tokens are cached on disk, so prompt is
+// only called when no valid token is cached.
+func NewDeviceCodeClient(apiURL, clientID, audience string, prompt func(DeviceAuthorization)) (*Client, error) {
+	return NewClientWithTokenSource(apiURL, CachedTokenSource(clientID, audience, DeviceCodeSource{
+		ClientID: clientID,
+		Audience: audience,
+		Prompt:   prompt,
+	}))
+}
+
+// NewTokenExchangeClient creates a client that exchanges subjectToken for a
+// token issued to audience. Exchanged tokens are cached on disk.
+func NewTokenExchangeClient(apiURL, clientID, clientSecret, subjectToken, audience string) (*Client, error) {
+	return NewClientWithTokenSource(apiURL, CachedTokenSource(clientID, audience, TokenExchangeSource{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		SubjectToken: subjectToken,
+		Audience:     audience,
+	}))
+}
+
+// NewCertificateClient creates a client that authenticates with a TLS client
+// certificate instead of an SSO token. caPEM is optional and replaces the
+// system roots when set.
+func NewCertificateClient(apiURL string, certPEM, keyPEM, caPEM []byte) (*Client, error) {
+	opts := []Option{WithEndpoint(apiURL), WithClientCertificate(certPEM, keyPEM)}
+	if len(caPEM) > 0 {
+		opts = append(opts, WithHTTPOptions(HTTPOptions{CABundle: caPEM}))
+	}
+
+	return New(opts...)
+}
+
+// NewClientWithTokenSource creates a client that sets a token of source on
+// every request. A nil source sends requests without a token.
+func NewClientWithTokenSource(apiURL string, source TokenSource) (*Client, error) {
+	return New(WithEndpoint(apiURL), WithTokenSource(source))
+}
+
+// SetDebug logs every API request and response, including their headers and
+// bodies, to the standard logger of resty. The Authorization header is
+// redacted.
+func (c *Client) SetDebug(debug bool) {
+	c.HTTPClient.SetDebug(debug)
+}
+
+// redactRequestLog keeps credentials out of the debug log of requests.
+func redactRequestLog(l *resty.RequestLog) error {
+	if l.Header.Get("Authorization") != "" {
+		l.Header.Set("Authorization", "REDACTED")
+	}
+	return nil
+}
+
+// SetUserAgent sets the User-Agent header of the API and SSO requests.
+func (c *Client) SetUserAgent(userAgent string) {
+	c.HTTPClient.SetHeader("User-Agent", userAgent)
+	c.authClient.SetHeader("User-Agent", userAgent)
+}
+
+// WithChangeComment returns a client that sends comment with every create,
+// update and delete request. It shares the HTTP clients of c.
+func (c *Client) WithChangeComment(comment string) API {
+	cc := *c
+	cc.changeComment = comment
+	return &cc
+}
+
+// WithContext returns a client that sends its requests with ctx, so they are
+// cancelled with it and logged with its logger. It shares the HTTP clients
+// of c.
+func (c *Client) WithContext(ctx context.Context) API {
+	cc := *c
+	cc.ctx = ctx
+	return &cc
+}
+
+// request starts a request with the context of c.
+func (c *Client) request() *resty.Request {
+	r := c.HTTPClient.R()
+	if c.ctx != nil {
+		r.SetContext(c.ctx)
+	}
+	return r
+}
+
+// writeRequest starts a request that modifies LanDB.
+func (c *Client) writeRequest() *resty.Request {
+	r := c.request()
+	if c.changeComment != "" {
+		r.SetHeader(ChangeCommentHeader, c.changeComment)
+	}
+	return r
+}
+
+// newRequestID returns a random UUID.
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// Token fetches a new access token with the token source of the client. A
+// token that could not be cached is returned with an error wrapping
+// ErrTokenNotCached.
+func (c *Client) Token() (*AuthResponse, error) {
+	if c.tokenSource == nil {
+		return nil, errors.New("client authenticates with a certificate and has no access token")
+	}
+
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return c.tokenSource.Token(ctx, c.authClient)
+}
+
+// HTTPOptions configures the transport of the API and SSO requests.
+type HTTPOptions struct {
+	// CABundle holds PEM encoded certificates trusted instead of the system
+	// roots.
+	CABundle []byte
+	// ProxyURL routes requests through a proxy instead of the one named by
+	// the environment.
+	ProxyURL string
+	// InsecureSkipVerify disables verification of server certificates. It
+	// is only meant for tests.
+	InsecureSkipVerify bool
+	// Timeout limits the duration of every request.
+	Timeout time.Duration
+}
+
+// SetHTTPOptions applies opts to both the API and the SSO requests.
+func (c *Client) SetHTTPOptions(opts HTTPOptions) error {
+	var rootCAs *x509.CertPool
+	if len(opts.CABundle) > 0 {
+		rootCAs = x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(opts.CABundle) {
+			return errors.New("invalid CA bundle: no certificates found")
+		}
+	}
+
+	if opts.ProxyURL != "" {
+		if _, err := url.Parse(opts.ProxyURL); err != nil {
+			return fmt.Errorf("invalid proxy URL: %w", err)
+		}
+	}
+
+	// The SSO client shares the transport, so TLS and proxy settings only
+	// need to be made once.
+	transport, err := c.HTTPClient.Transport()
+	if err != nil {
+		return err
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	if rootCAs != nil {
+		transport.TLSClientConfig.RootCAs = rootCAs
+	}
+	transport.TLSClientConfig.InsecureSkipVerify = opts.InsecureSkipVerify
+
+	if opts.ProxyURL != "" {
+		c.HTTPClient.SetProxy(opts.ProxyURL)
+	}
+
+	if opts.Timeout > 0 {
+		c.HTTPClient.SetTimeout(opts.Timeout)
+		c.authClient.SetTimeout(opts.Timeout)
+	}
+
+	return nil
+}
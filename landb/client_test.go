@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientHeaders(t *testing.T) {
+	var userAgents, requestIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		requestIDs = append(requestIDs, r.Header.Get(RequestIDHeader))
+	}))
+	defer server.Close()
+
+	cli, err := NewClientWithTokenSource(server.URL, nil)
+	require.NoError(t, err)
+	cli.SetUserAgent("terraform-provider-landb/1.2.3 terraform/1.10.0")
+
+	for range 2 {
+		_, err := cli.HTTPClient.R().Get(server.URL)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []string{"terraform-provider-landb/1.2.3 terraform/1.10.0", "terraform-provider-landb/1.2.3 terraform/1.10.0"}, userAgents)
+	require.Len(t, requestIDs, 2)
+	require.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, requestIDs[0])
+	require.NotEqual(t, requestIDs[0], requestIDs[1])
+}
+
+func TestChangeComment(t *testing.T) {
+	var comments []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		comments = append(comments, r.Header.Get(ChangeCommentHeader))
+	}))
+	defer server.Close()
+
+	cli, err := NewClientWithTokenSource(server.URL, nil)
+	require.NoError(t, err)
+
+	commented := cli.WithChangeComment("RITM0123456").(*Client)
+	_, err = commented.writeRequest().Post(server.URL)
+	require.NoError(t, err)
+	_, err = cli.writeRequest().Post(server.URL)
+	require.NoError(t, err)
+	_, err = commented.HTTPClient.R().Get(server.URL)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"RITM0123456", "", ""}, comments)
+}
+
+type contextKey struct{}
+
+func TestWithContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	cli, err := NewClientWithTokenSource(server.URL, nil)
+	require.NoError(t, err)
+
+	var contexts []context.Context
+	cli.HTTPClient.OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+		contexts = append(contexts, r.Request.Context())
+		return nil
+	})
+
+	ctx := context.WithValue(context.Background(), contextKey{}, "caller")
+	_, err = cli.WithContext(ctx).(*Client).request().Get(server.URL)
+	require.NoError(t, err)
+	_, err = cli.request().Get(server.URL)
+	require.NoError(t, err)
+
+	require.Len(t, contexts, 2)
+	require.Equal(t, "caller", contexts[0].Value(contextKey{}))
+	require.Nil(t, contexts[1].Value(contextKey{}))
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContactLookup(t *testing.T) {
+	apiEndpoint := "https://landb.cern.ch/api/"
+	clientID := "terraform-provider-landb"
+	clientSecret := os.Getenv("LANDB_SSO_CLIENT_SECRET")
+	audience := "production-microservice-landb-rest"
+	require.NotEmpty(t, clientSecret, "environment variable LANDB_SSO_CLIENT_SECRET must be set")
+
+	cli, err := landb.NewClient(apiEndpoint, clientID, clientSecret, audience)
+	require.NoError(t, err)
+
+	t.Log("Looking up e-group...")
+	egroup, err := cli.GetEGroup("terraform-provider-landb")
+	require.NoError(t, err)
+	require.Equal(t, "terraform-provider-landb", egroup.Name)
+	require.Equal(t, "terraform-provider-landb@cern.ch", egroup.Email)
+
+	t.Log("Looking up unknown person...")
+	_, err = cli.GetPerson("tf-provider-no-such-user")
+	require.Error(t, err)
+}
+
+func TestGetPerson(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/beta/persons/jdoe":
+			_ = json.NewEncoder(w).Encode(landb.Person{Username: "jdoe", FirstName: "John", LastName: "Doe", Email: "john.doe@cern.ch"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(landb.APIError{Code: "404", ErrorType: "Not Found", Message: "person not found"})
+		}
+	}))
+	defer server.Close()
+
+	cli, err := landb.NewClientWithTokenSource(server.URL, nil)
+	require.NoError(t, err)
+
+	person, err := cli.GetPerson("jdoe")
+	require.NoError(t, err)
+	require.Equal(t, &landb.Person{Username: "jdoe", FirstName: "John", LastName: "Doe", Email: "john.doe@cern.ch"}, person)
+
+	_, err = cli.GetPerson("nobody")
+	require.EqualError(t, err, "get person failed: person not found")
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"fmt"
+)
+
+const (
+	personsURL = "persons/"
+	egroupsURL = "egroups/"
+)
+
+func (c *Client) GetPerson(username string) (*Person, error) {
+	url := c.endpointURL(personsURL + username)
+
+	var apiErr APIError
+	resp, err := c.request().
+		SetResult(&Person{}).
+		SetError(&apiErr).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("get person failed: %s", apiErr.Message)
+	}
+
+	return resp.Result().(*Person), nil
+}
+
+func (c *Client) GetEGroup(name string) (*EGroup, error) {
+	url := c.endpointURL(egroupsURL + name)
+
+	var apiErr APIError
+	resp, err := c.request().
+		SetResult(&EGroup{}).
+		SetError(&apiErr).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("get egroup failed: %s", apiErr.Message)
+	}
+
+	return resp.Result().(*EGroup), nil
+}
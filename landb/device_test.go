@@ -10,7 +10,7 @@ import (
 	"testing"
 	"time"
 
-	landb "landb/internal/client"
+	"github.com/barnes-c/terraform-provider-landb/landb"
 
 	"github.com/stretchr/testify/require"
 )
@@ -90,6 +90,10 @@ func TestDeviceCRUD(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, createdDevice.Name, readDevice.Name)
 
+	t.Log("Listing device addresses...")
+	_, err = cli.GetDeviceIPAddresses(deviceName)
+	require.NoError(t, err)
+
 	t.Log("Updating device...")
 	readDevice.Description = "Updated via test"
 	updatedDevice, err := cli.UpdateDevice(readDevice.Name, *readDevice)
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"fmt"
+)
+
+const devicesURL = "devices/"
+
+func (c *Client) CreateDevice(device Device) (Device, error) {
+	url := c.endpointURL(devicesURL)
+
+	var result []Device
+	var apiErr APIError
+
+	resp, err := c.writeRequest().
+		SetBody([]Device{device}).
+		SetResult(&result).
+		SetError(&apiErr).
+		Post(url)
+	if err != nil {
+		return Device{}, err
+	}
+
+	if resp.IsError() {
+		return Device{}, fmt.Errorf("create device failed: %s", apiErr.Message)
+	}
+
+	return result[0], nil
+}
+
+func (c *Client) GetDevice(name string) (*Device, error) {
+	url := c.endpointURL(devicesURL + name)
+
+	var apiErr APIError
+	resp, err := c.request().
+		SetResult(&Device{}).
+		SetError(&apiErr).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("get device failed: %s", apiErr.Message)
+	}
+
+	return resp.Result().(*Device), nil
+}
+
+func (c *Client) UpdateDevice(name string, device Device) (*Device, error) {
+	url := c.endpointURL(devicesURL + name)
+
+	var apiErr APIError
+	resp, err := c.writeRequest().
+		SetBody(device).
+		SetResult(&Device{}).
+		SetError(&apiErr).
+		Put(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("update device failed: %s", apiErr.Message)
+	}
+
+	return resp.Result().(*Device), nil
+}
+
+func (c *Client) DeleteDevice(name string, version int) error {
+	url := c.endpointURL(devicesURL + name)
+
+	var apiErr APIError
+	resp, err := c.writeRequest().
+		SetQueryParam("version", fmt.Sprintf("%d", version)).
+		SetError(&apiErr).
+		Delete(url)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("delete device failed: %s", apiErr.Message)
+	}
+
+	return nil
+}
+
+func (c *Client) GetDeviceIPAddresses(name string) ([]IPAddress, error) {
+	url := c.endpointURL(devicesURL + name + "/ip-addresses")
+
+	var result []IPAddress
+	var apiErr APIError
+
+	resp, err := c.request().
+		SetResult(&result).
+		SetError(&apiErr).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("get device ip addresses failed: %s", apiErr.Message)
+	}
+
+	return result, nil
+}
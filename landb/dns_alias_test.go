@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDNSAliasCRUD(t *testing.T) {
+	apiEndpoint := "https://landb.cern.ch/api/"
+	clientID := "terraform-provider-landb"
+	clientSecret := os.Getenv("LANDB_SSO_CLIENT_SECRET")
+	audience := "production-microservice-landb-rest"
+	require.NotEmpty(t, clientSecret, "environment variable LANDB_SSO_CLIENT_SECRET must be set")
+
+	cli, err := landb.NewClient(apiEndpoint, clientID, clientSecret, audience)
+	require.NoError(t, err)
+
+	timestamp := fmt.Sprintf("%d", time.Now().UnixNano())
+	last5 := timestamp[len(timestamp)-5:]
+
+	contact := landb.Contact{
+		Type: "EGROUP",
+		EGroup: landb.EGroup{
+			Name:  "terraform-provider-landb",
+			Email: "terraform-provider-landb@cern.ch",
+		},
+	}
+
+	var interfaces []string
+	for _, color := range []string{"BLUE", "GREEN"} {
+		deviceName := fmt.Sprintf("TF-TEST-%s-%s", color, last5)
+		device := landb.Device{
+			Name:                 deviceName,
+			Description:          "Terraform test device for DNS aliases",
+			Zone:                 "ZONE1",
+			DHCPResponse:         "ALWAYS",
+			IPv4InDNSAndFirewall: true,
+			IPv6InDNSAndFirewall: true,
+			ManagerLock:          "NO_LOCK",
+			Ownership:            "CERN",
+			Location: landb.Location{
+				Building: "31",
+				Floor:    "1",
+				Room:     "006",
+			},
+			Type:        "COMPUTER",
+			Manager:     contact,
+			Responsible: contact,
+			User:        contact,
+		}
+
+		t.Logf("Creating device: %s", deviceName)
+		_, err := cli.CreateDevice(device)
+		require.NoError(t, err)
+
+		iface, err := cli.CreateInterface(deviceName, landb.Interface{
+			Name:    fmt.Sprintf("%s.CERN.CH", deviceName),
+			Service: "S513-C-IP250",
+		})
+		require.NoError(t, err)
+		interfaces = append(interfaces, iface.Name)
+
+		defer func() {
+			t.Logf("Deleting device: %s", deviceName)
+			current, err := cli.GetDevice(deviceName)
+			require.NoError(t, err)
+			err = cli.DeleteDevice(deviceName, current.Version)
+			require.NoError(t, err)
+		}()
+	}
+
+	aliasName := fmt.Sprintf("TF-TEST-ALIAS-%s", last5)
+	alias := landb.DNSAlias{
+		Name:          aliasName,
+		DeviceName:    fmt.Sprintf("TF-TEST-BLUE-%s", last5),
+		InterfaceName: interfaces[0],
+		Scope:         "IPV4_AND_IPV6",
+	}
+
+	t.Logf("Creating alias: %s", aliasName)
+	created, err := cli.CreateDNSAlias(alias)
+	require.NoError(t, err)
+	require.Equal(t, alias.Name, created.Name)
+
+	t.Log("Moving alias to the green device...")
+	created.DeviceName = fmt.Sprintf("TF-TEST-GREEN-%s", last5)
+	created.InterfaceName = interfaces[1]
+	updated, err := cli.UpdateDNSAlias(aliasName, created)
+	require.NoError(t, err)
+	require.Equal(t, interfaces[1], updated.InterfaceName)
+
+	t.Log("Reading alias...")
+	read, err := cli.GetDNSAlias(aliasName)
+	require.NoError(t, err)
+	require.Equal(t, updated.DeviceName, read.DeviceName)
+
+	t.Logf("Deleting alias: %s", aliasName)
+	err = cli.DeleteDNSAlias(aliasName, read.Version)
+	require.NoError(t, err)
+}
+
+func TestGetDNSAliasNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(landb.APIError{Code: "404", ErrorType: "Not Found", Message: "dns alias not found"})
+	}))
+	defer server.Close()
+
+	cli, err := landb.NewClientWithTokenSource(server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = cli.GetDNSAlias("test-alias")
+	require.ErrorIs(t, err, landb.ErrNotFound)
+}
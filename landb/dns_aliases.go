@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const dnsAliasesURL = "dns-aliases/"
+
+func (c *Client) CreateDNSAlias(alias DNSAlias) (DNSAlias, error) {
+	url := c.endpointURL(dnsAliasesURL)
+
+	var result []DNSAlias
+	var apiErr APIError
+
+	resp, err := c.writeRequest().
+		SetBody([]DNSAlias{alias}).
+		SetResult(&result).
+		SetError(&apiErr).
+		Post(url)
+	if err != nil {
+		return DNSAlias{}, err
+	}
+	if resp.IsError() {
+		return DNSAlias{}, fmt.Errorf("create dns alias failed: %s", apiErr.Message)
+	}
+	return result[0], nil
+}
+
+func (c *Client) GetDNSAlias(name string) (*DNSAlias, error) {
+	url := c.endpointURL(dnsAliasesURL + name)
+
+	var apiErr APIError
+	resp, err := c.request().
+		SetResult(&DNSAlias{}).
+		SetError(&apiErr).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, fmt.Errorf("get dns alias failed: %w", ErrNotFound)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("get dns alias failed: %s", apiErr.Message)
+	}
+	return resp.Result().(*DNSAlias), nil
+}
+
+func (c *Client) UpdateDNSAlias(name string, alias DNSAlias) (*DNSAlias, error) {
+	url := c.endpointURL(dnsAliasesURL + name)
+
+	var apiErr APIError
+	resp, err := c.writeRequest().
+		SetBody(alias).
+		SetResult(&DNSAlias{}).
+		SetError(&apiErr).
+		Put(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("update dns alias failed: %s", apiErr.Message)
+	}
+	return resp.Result().(*DNSAlias), nil
+}
+
+func (c *Client) DeleteDNSAlias(name string, version int) error {
+	url := c.endpointURL(dnsAliasesURL + name)
+
+	var apiErr APIError
+	resp, err := c.writeRequest().
+		SetQueryParam("version", fmt.Sprintf("%d", version)).
+		SetError(&apiErr).
+		Delete(url)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("delete dns alias failed: %s", apiErr.Message)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package landb is a Go client for the CERN LanDB REST API. It is the client
+// the Terraform provider is built on and can be used by other tools that
+// manage LanDB devices, sets and related objects.
+//
+// Create a client with New and the options for the way it authenticates:
+//
+//	client, err := landb.New(
+//		landb.WithClientCredentials(clientID, clientSecret, "production-microservice-landb-rest"),
+//		landb.WithUserAgent("inventory-reconciler/1.0"),
+//	)
+//
+// Code that only needs the LanDB operations should depend on the API
+// interface, which Client implements, so a fake can stand in for it in
+// tests.
+//
+// The models are generated from the OpenAPI document of LanDB into package
+// landbapi, together with a client for every endpoint of the document.
+// Client.Endpoints returns that client for endpoints Client has no method
+// for yet.
+//
+// The package follows the semantic versioning of the module: exported
+// identifiers only change incompatibly with a new major version.
+package landb
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/barnes-c/terraform-provider-landb/landb/landbapi"
+)
+
+// Endpoints returns the client generated from the OpenAPI document of LanDB.
+// It covers endpoints the Client has no method for yet, and sends its
+// requests with the transport, token, User-Agent and change comment of c.
+func (c *Client) Endpoints() (*landbapi.ClientWithResponses, error) {
+	return landbapi.NewClientWithResponses(c.baseURL,
+		landbapi.WithHTTPClient(c.HTTPClient.GetClient()),
+		landbapi.WithRequestEditorFn(c.editRequest),
+	)
+}
+
+// editRequest sets the version of the endpoint and the headers New adds to
+// resty requests on a request of the generated client.
+func (c *Client) editRequest(_ context.Context, req *http.Request) error {
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return err
+	}
+	req.URL.Path = base.Path + c.versionedPath(strings.TrimPrefix(req.URL.Path, base.Path))
+	req.URL.RawPath = ""
+
+	req.Header.Set(RequestIDHeader, newRequestID())
+	req.Header.Set("User-Agent", c.HTTPClient.Header.Get("User-Agent"))
+
+	if c.changeComment != "" && req.Method != http.MethodGet {
+		req.Header.Set(ChangeCommentHeader, c.changeComment)
+	}
+
+	if c.tokenSource == nil {
+		return nil
+	}
+
+	authResp, err := c.tokenSource.Token(req.Context(), c.authClient)
+	if err != nil && !errors.Is(err, ErrTokenNotCached) {
+		return fmt.Errorf("failed to authenticate: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+authResp.AccessToken)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpoints(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/beta/devices/TEST-DEVICE", r.URL.Path)
+		header = r.Header
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Device{Name: "TEST-DEVICE", Version: 3})
+	}))
+	defer server.Close()
+
+	source := &fakeTokenSource{authResp: AuthResponse{AccessToken: "landb-token"}}
+	cli, err := NewClientWithTokenSource(server.URL, source)
+	require.NoError(t, err)
+	cli.SetUserAgent("terraform-provider-landb/1.2.3")
+
+	endpoints, err := cli.Endpoints()
+	require.NoError(t, err)
+
+	resp, err := endpoints.GetDeviceWithResponse(context.Background(), "TEST-DEVICE")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	require.Equal(t, &Device{Name: "TEST-DEVICE", Version: 3}, resp.JSON200)
+
+	require.Equal(t, "Bearer landb-token", header.Get("Authorization"))
+	require.Equal(t, "terraform-provider-landb/1.2.3", header.Get("User-Agent"))
+	require.NotEmpty(t, header.Get(RequestIDHeader))
+}
@@ -0,0 +1,8 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+// DefaultEndpoint is the production LanDB API.
+const DefaultEndpoint = "https://landb.cern.ch/api/"
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetHTTPOptions(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(AuthResponse{AccessToken: "landb-token", ExpiresIn: 300}))
+	}))
+	defer server.Close()
+
+	oldTokenURL := tokenURL
+	tokenURL = server.URL + "/token"
+	defer func() { tokenURL = oldTokenURL }()
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	cli, err := NewTokenExchangeClient(server.URL, "landb-cli", "", "user-token", "production-microservice-landb-rest")
+	require.NoError(t, err)
+
+	_, err = cli.Token()
+	require.Error(t, err, "the test server is not trusted by default")
+
+	require.NoError(t, cli.SetHTTPOptions(HTTPOptions{CABundle: caPEM, Timeout: 100 * time.Millisecond}))
+
+	authResp, err := cli.Token()
+	require.NoError(t, err)
+	require.Equal(t, "landb-token", authResp.AccessToken)
+
+	_, err = cli.HTTPClient.R().Get(server.URL + "/slow")
+	require.Error(t, err, "the request exceeds the timeout")
+
+	cli, err = NewStaticTokenClient(server.URL, "landb-token")
+	require.NoError(t, err)
+	require.NoError(t, cli.SetHTTPOptions(HTTPOptions{InsecureSkipVerify: true}))
+
+	resp, err := cli.HTTPClient.R().Get(server.URL)
+	require.NoError(t, err)
+	require.False(t, resp.IsError())
+
+	require.Error(t, cli.SetHTTPOptions(HTTPOptions{CABundle: []byte("not a certificate")}))
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterfaceCRUD(t *testing.T) {
+	apiEndpoint := "https://landb.cern.ch/api/"
+	clientID := "terraform-provider-landb"
+	clientSecret := os.Getenv("LANDB_SSO_CLIENT_SECRET")
+	audience := "production-microservice-landb-rest"
+	require.NotEmpty(t, clientSecret, "environment variable LANDB_SSO_CLIENT_SECRET must be set")
+
+	cli, err := landb.NewClient(apiEndpoint, clientID, clientSecret, audience)
+	require.NoError(t, err)
+
+	timestamp := fmt.Sprintf("%d", time.Now().UnixNano())
+	last5 := timestamp[len(timestamp)-5:]
+	deviceName := fmt.Sprintf("TF-TEST-DEVICE-%s", last5)
+
+	contact := landb.Contact{
+		Type: "EGROUP",
+		EGroup: landb.EGroup{
+			Name:  "terraform-provider-landb",
+			Email: "terraform-provider-landb@cern.ch",
+		},
+	}
+
+	device := landb.Device{
+		Name:                 deviceName,
+		Description:          "Terraform test device for interfaces",
+		Zone:                 "ZONE1",
+		DHCPResponse:         "ALWAYS",
+		IPv4InDNSAndFirewall: true,
+		IPv6InDNSAndFirewall: true,
+		ManagerLock:          "NO_LOCK",
+		Ownership:            "CERN",
+		Location: landb.Location{
+			Building: "31",
+			Floor:    "1",
+			Room:     "006",
+		},
+		Type:        "COMPUTER",
+		Manager:     contact,
+		Responsible: contact,
+		User:        contact,
+	}
+
+	t.Logf("Creating device: %s", deviceName)
+	createdDevice, err := cli.CreateDevice(device)
+	require.NoError(t, err)
+
+	defer func() {
+		t.Logf("Deleting device: %s", deviceName)
+		current, err := cli.GetDevice(deviceName)
+		require.NoError(t, err)
+		err = cli.DeleteDevice(createdDevice.Name, current.Version)
+		require.NoError(t, err)
+	}()
+
+	ifaceName := fmt.Sprintf("%s.CERN.CH", deviceName)
+	iface := landb.Interface{
+		Name:       ifaceName,
+		MACAddress: "02-00-00-00-00-01",
+		Service:    "S513-C-IP250",
+	}
+
+	t.Logf("Creating interface: %s", ifaceName)
+	created, err := cli.CreateInterface(deviceName, iface)
+	require.NoError(t, err)
+	require.Equal(t, iface.Name, created.Name)
+	require.NotEmpty(t, created.IPv4, "an IPv4 address should be allocated from the service")
+
+	t.Log("Reading interface...")
+	read, err := cli.GetInterface(deviceName, ifaceName)
+	require.NoError(t, err)
+	require.Equal(t, iface.MACAddress, read.MACAddress)
+
+	t.Log("Updating interface...")
+	read.MACAddress = "02-00-00-00-00-02"
+	updated, err := cli.UpdateInterface(deviceName, ifaceName, *read)
+	require.NoError(t, err)
+	require.Equal(t, "02-00-00-00-00-02", updated.MACAddress)
+
+	t.Logf("Deleting interface: %s", ifaceName)
+	err = cli.DeleteInterface(deviceName, ifaceName, updated.Version)
+	require.NoError(t, err)
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const interfacesURL = "devices/%s/interfaces/"
+
+func (c *Client) CreateInterface(deviceName string, iface Interface) (Interface, error) {
+	url := c.endpointURL(fmt.Sprintf(interfacesURL, deviceName))
+
+	var result []Interface
+	var apiErr APIError
+
+	resp, err := c.writeRequest().
+		SetBody([]Interface{iface}).
+		SetResult(&result).
+		SetError(&apiErr).
+		Post(url)
+	if err != nil {
+		return Interface{}, err
+	}
+	if resp.IsError() {
+		return Interface{}, fmt.Errorf("create interface failed: %s", apiErr.Message)
+	}
+	return result[0], nil
+}
+
+func (c *Client) GetInterface(deviceName, name string) (*Interface, error) {
+	url := c.endpointURL(fmt.Sprintf(interfacesURL+"%s", deviceName, name))
+
+	var apiErr APIError
+	resp, err := c.request().
+		SetResult(&Interface{}).
+		SetError(&apiErr).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, fmt.Errorf("get interface failed: %w", ErrNotFound)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("get interface failed: %s", apiErr.Message)
+	}
+	return resp.Result().(*Interface), nil
+}
+
+func (c *Client) UpdateInterface(deviceName, name string, iface Interface) (*Interface, error) {
+	url := c.endpointURL(fmt.Sprintf(interfacesURL+"%s", deviceName, name))
+
+	var apiErr APIError
+	resp, err := c.writeRequest().
+		SetBody(iface).
+		SetResult(&Interface{}).
+		SetError(&apiErr).
+		Put(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("update interface failed: %s", apiErr.Message)
+	}
+	return resp.Result().(*Interface), nil
+}
+
+func (c *Client) DeleteInterface(deviceName, name string, version int) error {
+	url := c.endpointURL(fmt.Sprintf(interfacesURL+"%s", deviceName, name))
+
+	var apiErr APIError
+	resp, err := c.writeRequest().
+		SetQueryParam("version", fmt.Sprintf("%d", version)).
+		SetError(&apiErr).
+		Delete(url)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("delete interface failed: %s", apiErr.Message)
+	}
+	return nil
+}
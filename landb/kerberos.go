@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+)
+
+const kerberosRedirectURI = "http://localhost"
+
+// The Kerberos endpoints are variables so tests can point them at a local server.
+var (
+	kerberosAuthURL  = "https://auth.cern.ch/auth/realms/kerberos/protocol/openid-connect/auth"
+	kerberosTokenURL = "https://auth.cern.ch/auth/realms/kerberos/protocol/openid-connect/token"
+)
+
+// Negotiator produces the value of a SPNEGO Authorization header for a
+// service principal such as HTTP/auth.cern.ch.
+type Negotiator interface {
+	Negotiate(spn string) (string, error)
+}
+
+// CCacheNegotiator negotiates with the Kerberos tickets of the user's
+// credential cache.
+type CCacheNegotiator struct {
+	client *client.Client
+}
+
+// NewCCacheNegotiator loads the credential cache named by KRB5CCNAME, or the
+// default /tmp/krb5cc_<uid>, and the Kerberos configuration named by
+// KRB5_CONFIG, or /etc/krb5.conf.
+func NewCCacheNegotiator() (*CCacheNegotiator, error) {
+	cfgPath := os.Getenv("KRB5_CONFIG")
+	if cfgPath == "" {
+		cfgPath = "/etc/krb5.conf"
+	}
+	cfg, err := config.Load(cfgPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading kerberos configuration %s: %w", cfgPath, err)
+	}
+
+	ccachePath, err := ccachePath(os.Getenv("KRB5CCNAME"))
+	if err != nil {
+		return nil, err
+	}
+	ccache, err := credentials.LoadCCache(ccachePath)
+	if err != nil {
+		return nil, fmt.Errorf("loading kerberos credential cache %s: %w", ccachePath, err)
+	}
+
+	cl, err := client.NewFromCCache(ccache, cfg, client.DisablePAFXFAST(true))
+	if err != nil {
+		return nil, fmt.Errorf("creating kerberos client: %w", err)
+	}
+
+	return &CCacheNegotiator{client: cl}, nil
+}
+
+// ccachePath returns the file of the credential cache ccname, which has the
+// format of KRB5CCNAME. Only file caches can be read, so the caches kept by
+// a daemon or the kernel that some distributions default to are rejected
+// with a hint on how to get a file cache instead.
+func ccachePath(ccname string) (string, error) {
+	if ccname == "" {
+		return fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid()), nil
+	}
+
+	typ, residual, ok := strings.Cut(ccname, ":")
+	if !ok || typ == "FILE" {
+		return strings.TrimPrefix(ccname, "FILE:"), nil
+	}
+	switch typ {
+	case "KCM", "DIR", "KEYRING", "API", "MEMORY":
+		file := fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid())
+		return "", fmt.Errorf("kerberos credential cache %s is of type %s, which cannot be read; "+
+			"run \"kinit -c FILE:%s\" and set KRB5CCNAME=FILE:%s", ccname, typ, file, file)
+	}
+	return residual, nil
+}
+
+func (n *CCacheNegotiator) Negotiate(spn string) (string, error) {
+	s := spnego.SPNEGOClient(n.client, spn)
+	if err := s.AcquireCred(); err != nil {
+		return "", fmt.Errorf("acquiring kerberos credentials: %w", err)
+	}
+
+	token, err := s.InitSecContext()
+	if err != nil {
+		return "", fmt.Errorf("initializing security context: %w", err)
+	}
+
+	b, err := token.Marshal()
+	if err != nil {
+		return "", fmt.Errorf("marshalling SPNEGO token: %w", err)
+	}
+
+	return "Negotiate " + base64.StdEncoding.EncodeToString(b), nil
+}
+
+// KerberosSource obtains tokens with the Kerberos tickets available to
+// Negotiator. A token is reused until it is about to expire.
+type KerberosSource struct {
+	Negotiator Negotiator
+	ClientID   string
+	Audience   string
+
+	mu        sync.Mutex
+	spnego    *resty.Client
+	token     *AuthResponse
+	expiresAt time.Time
+}
+
+func (s *KerberosSource) Token(ctx context.Context, client *resty.Client) (*AuthResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && time.Until(s.expiresAt) >= tokenCacheMargin {
+		return s.token, nil
+	}
+
+	if s.spnego == nil {
+		s.spnego = newSPNEGOClient(client)
+	}
+
+	authResp, err := authenticateKerberos(ctx, s.spnego, client, s.Negotiator, s.ClientID, s.Audience)
+	if err != nil {
+		return nil, err
+	}
+
+	s.token = authResp
+	s.expiresAt = time.Now().Add(time.Duration(authResp.ExpiresIn) * time.Second)
+	return authResp, nil
+}
+
+// AuthenticateKerberos logs in to CERN SSO with SPNEGO and exchanges the
+// resulting user token for one issued to audience.
+func AuthenticateKerberos(negotiator Negotiator, clientID, audience string) (*AuthResponse, error) {
+	client := resty.New()
+	return authenticateKerberos(context.Background(), newSPNEGOClient(client), client, negotiator, clientID, audience)
+}
+
+// newSPNEGOClient returns a client sharing the transport and User-Agent of
+// client that does not follow redirects, as the SPNEGO login needs the
+// redirect to the application. client itself is left alone since it is
+// shared with other requests.
+func newSPNEGOClient(client *resty.Client) *resty.Client {
+	hc := *client.GetClient()
+	spnego := resty.NewWithClient(&hc)
+	spnego.SetHeader("User-Agent", client.Header.Get("User-Agent"))
+	spnego.SetRedirectPolicy(resty.RedirectPolicyFunc(func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}))
+	return spnego
+}
+
+// authenticateKerberos sends the SPNEGO login with spnego, which must not
+// follow redirects, and the token requests with client.
+func authenticateKerberos(ctx context.Context, spnego, client *resty.Client, negotiator Negotiator, clientID, audience string) (*AuthResponse, error) {
+	u, err := url.Parse(kerberosAuthURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kerberos authentication URL: %w", err)
+	}
+
+	header, err := negotiator.Negotiate("HTTP/" + u.Hostname())
+	if err != nil {
+		return nil, fmt.Errorf("kerberos negotiation failed: %w", err)
+	}
+
+	resp, err := spnego.R().
+		SetContext(ctx).
+		SetHeader("Authorization", header).
+		SetQueryParams(map[string]string{
+			"client_id":     clientID,
+			"response_type": "code",
+			"redirect_uri":  kerberosRedirectURI,
+		}).
+		Get(kerberosAuthURL)
+	if err != nil {
+		return nil, fmt.Errorf("authentication request failed: %w", err)
+	}
+	if resp.StatusCode() != http.StatusFound {
+		return nil, fmt.Errorf("authentication error [%d]: %s", resp.StatusCode(), resp.String())
+	}
+
+	location, err := url.Parse(resp.Header().Get("Location"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid authentication redirect: %w", err)
+	}
+	code := location.Query().Get("code")
+	if code == "" {
+		return nil, fmt.Errorf("authentication redirect carries no code: %s", location.Query().Get("error_description"))
+	}
+
+	var userResp AuthResponse
+	resp, err = client.R().
+		SetContext(ctx).
+		SetFormData(map[string]string{
+			"grant_type":   "authorization_code",
+			"client_id":    clientID,
+			"code":         code,
+			"redirect_uri": kerberosRedirectURI,
+		}).
+		SetResult(&userResp).
+		Post(kerberosTokenURL)
+	if err != nil {
+		return nil, fmt.Errorf("token request failed: %w", err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("token error [%d]: %s", resp.StatusCode(), resp.String())
+	}
+
+	return exchangeToken(ctx, client, kerberosTokenURL, clientID, "", userResp.AccessToken, audience)
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type mockNegotiator struct {
+	spn   string
+	calls int
+}
+
+func (n *mockNegotiator) Negotiate(spn string) (string, error) {
+	n.spn = spn
+	n.calls++
+	return "Negotiate bW9jaw==", nil
+}
+
+func newKerberosServer(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Negotiate bW9jaw==" {
+			w.Header().Set("WWW-Authenticate", "Negotiate")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.Equal(t, "terraform-provider-landb", r.URL.Query().Get("client_id"))
+		http.Redirect(w, r, kerberosRedirectURI+"/?code=abc", http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		var token string
+		switch r.PostForm.Get("grant_type") {
+		case "authorization_code":
+			require.Equal(t, "abc", r.PostForm.Get("code"))
+			token = "user-token"
+		case "urn:ietf:params:oauth:grant-type:token-exchange":
+			require.Equal(t, "user-token", r.PostForm.Get("subject_token"))
+			require.Equal(t, "production-microservice-landb-rest", r.PostForm.Get("audience"))
+			token = "landb-token"
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(AuthResponse{AccessToken: token, ExpiresIn: 300, TokenType: "Bearer"}))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	oldAuthURL, oldTokenURL := kerberosAuthURL, kerberosTokenURL
+	kerberosAuthURL, kerberosTokenURL = server.URL+"/auth", server.URL+"/token"
+	t.Cleanup(func() { kerberosAuthURL, kerberosTokenURL = oldAuthURL, oldTokenURL })
+}
+
+func TestAuthenticateKerberos(t *testing.T) {
+	newKerberosServer(t)
+
+	negotiator := &mockNegotiator{}
+	authResp, err := AuthenticateKerberos(negotiator, "terraform-provider-landb", "production-microservice-landb-rest")
+	require.NoError(t, err)
+	require.Equal(t, "landb-token", authResp.AccessToken)
+	require.Equal(t, "HTTP/127.0.0.1", negotiator.spn)
+}
+
+func TestKerberosSource(t *testing.T) {
+	newKerberosServer(t)
+
+	client := resty.New()
+	negotiator := &mockNegotiator{}
+	source := &KerberosSource{Negotiator: negotiator, ClientID: "terraform-provider-landb", Audience: "production-microservice-landb-rest"}
+
+	for range 2 {
+		authResp, err := source.Token(context.Background(), client)
+		require.NoError(t, err)
+		require.Equal(t, "landb-token", authResp.AccessToken)
+	}
+	require.Equal(t, 1, negotiator.calls)
+	require.Nil(t, client.GetClient().CheckRedirect)
+}
+
+func TestCCachePath(t *testing.T) {
+	defaultPath := fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid())
+
+	tests := map[string]struct {
+		ccname string
+		want   string
+		err    string
+	}{
+		"unset":   {ccname: "", want: defaultPath},
+		"path":    {ccname: "/tmp/krb5cc_test", want: "/tmp/krb5cc_test"},
+		"file":    {ccname: "FILE:/tmp/krb5cc_test", want: "/tmp/krb5cc_test"},
+		"kcm":     {ccname: "KCM:1000", err: "is of type KCM"},
+		"dir":     {ccname: "DIR:/run/user/1000/krb5cc", err: "is of type DIR"},
+		"keyring": {ccname: "KEYRING:persistent:1000", err: "is of type KEYRING"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ccachePath(tt.ccname)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				require.ErrorContains(t, err, "KRB5CCNAME=FILE:"+defaultPath)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,545 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../landb/api.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../landb/api.go -destination=../landb/landbmock/api.go -package=landbmock
+//
+
+// Package landbmock is a generated GoMock package.
+package landbmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	landb "github.com/barnes-c/terraform-provider-landb/landb"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// CreateDNSAlias mocks base method.
+func (m *MockAPI) CreateDNSAlias(alias landb.DNSAlias) (landb.DNSAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDNSAlias", alias)
+	ret0, _ := ret[0].(landb.DNSAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDNSAlias indicates an expected call of CreateDNSAlias.
+func (mr *MockAPIMockRecorder) CreateDNSAlias(alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDNSAlias", reflect.TypeOf((*MockAPI)(nil).CreateDNSAlias), alias)
+}
+
+// CreateDevice mocks base method.
+func (m *MockAPI) CreateDevice(device landb.Device) (landb.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDevice", device)
+	ret0, _ := ret[0].(landb.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDevice indicates an expected call of CreateDevice.
+func (mr *MockAPIMockRecorder) CreateDevice(device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevice", reflect.TypeOf((*MockAPI)(nil).CreateDevice), device)
+}
+
+// CreateInterface mocks base method.
+func (m *MockAPI) CreateInterface(deviceName string, iface landb.Interface) (landb.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterface", deviceName, iface)
+	ret0, _ := ret[0].(landb.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterface indicates an expected call of CreateInterface.
+func (mr *MockAPIMockRecorder) CreateInterface(deviceName, iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterface", reflect.TypeOf((*MockAPI)(nil).CreateInterface), deviceName, iface)
+}
+
+// CreateSet mocks base method.
+func (m *MockAPI) CreateSet(set landb.Set) (landb.Set, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSet", set)
+	ret0, _ := ret[0].(landb.Set)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSet indicates an expected call of CreateSet.
+func (mr *MockAPIMockRecorder) CreateSet(set any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSet", reflect.TypeOf((*MockAPI)(nil).CreateSet), set)
+}
+
+// CreateSetAttachment mocks base method.
+func (m *MockAPI) CreateSetAttachment(setName string, att landb.SetAttachment) (landb.SetAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSetAttachment", setName, att)
+	ret0, _ := ret[0].(landb.SetAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSetAttachment indicates an expected call of CreateSetAttachment.
+func (mr *MockAPIMockRecorder) CreateSetAttachment(setName, att any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSetAttachment", reflect.TypeOf((*MockAPI)(nil).CreateSetAttachment), setName, att)
+}
+
+// DeleteDNSAlias mocks base method.
+func (m *MockAPI) DeleteDNSAlias(name string, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDNSAlias", name, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDNSAlias indicates an expected call of DeleteDNSAlias.
+func (mr *MockAPIMockRecorder) DeleteDNSAlias(name, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDNSAlias", reflect.TypeOf((*MockAPI)(nil).DeleteDNSAlias), name, version)
+}
+
+// DeleteDevice mocks base method.
+func (m *MockAPI) DeleteDevice(name string, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDevice", name, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDevice indicates an expected call of DeleteDevice.
+func (mr *MockAPIMockRecorder) DeleteDevice(name, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockAPI)(nil).DeleteDevice), name, version)
+}
+
+// DeleteInterface mocks base method.
+func (m *MockAPI) DeleteInterface(deviceName, name string, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInterface", deviceName, name, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInterface indicates an expected call of DeleteInterface.
+func (mr *MockAPIMockRecorder) DeleteInterface(deviceName, name, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInterface", reflect.TypeOf((*MockAPI)(nil).DeleteInterface), deviceName, name, version)
+}
+
+// DeleteSet mocks base method.
+func (m *MockAPI) DeleteSet(name string, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSet", name, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSet indicates an expected call of DeleteSet.
+func (mr *MockAPIMockRecorder) DeleteSet(name, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSet", reflect.TypeOf((*MockAPI)(nil).DeleteSet), name, version)
+}
+
+// DeleteSetAttachment mocks base method.
+func (m *MockAPI) DeleteSetAttachment(setName, attachmentName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSetAttachment", setName, attachmentName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSetAttachment indicates an expected call of DeleteSetAttachment.
+func (mr *MockAPIMockRecorder) DeleteSetAttachment(setName, attachmentName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSetAttachment", reflect.TypeOf((*MockAPI)(nil).DeleteSetAttachment), setName, attachmentName)
+}
+
+// FindServices mocks base method.
+func (m *MockAPI) FindServices(building, outlet string) ([]landb.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindServices", building, outlet)
+	ret0, _ := ret[0].([]landb.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindServices indicates an expected call of FindServices.
+func (mr *MockAPIMockRecorder) FindServices(building, outlet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServices", reflect.TypeOf((*MockAPI)(nil).FindServices), building, outlet)
+}
+
+// GetDNSAlias mocks base method.
+func (m *MockAPI) GetDNSAlias(name string) (*landb.DNSAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDNSAlias", name)
+	ret0, _ := ret[0].(*landb.DNSAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDNSAlias indicates an expected call of GetDNSAlias.
+func (mr *MockAPIMockRecorder) GetDNSAlias(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNSAlias", reflect.TypeOf((*MockAPI)(nil).GetDNSAlias), name)
+}
+
+// GetDevice mocks base method.
+func (m *MockAPI) GetDevice(name string) (*landb.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevice", name)
+	ret0, _ := ret[0].(*landb.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevice indicates an expected call of GetDevice.
+func (mr *MockAPIMockRecorder) GetDevice(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevice", reflect.TypeOf((*MockAPI)(nil).GetDevice), name)
+}
+
+// GetDeviceIPAddresses mocks base method.
+func (m *MockAPI) GetDeviceIPAddresses(name string) ([]landb.IPAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceIPAddresses", name)
+	ret0, _ := ret[0].([]landb.IPAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceIPAddresses indicates an expected call of GetDeviceIPAddresses.
+func (mr *MockAPIMockRecorder) GetDeviceIPAddresses(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceIPAddresses", reflect.TypeOf((*MockAPI)(nil).GetDeviceIPAddresses), name)
+}
+
+// GetEGroup mocks base method.
+func (m *MockAPI) GetEGroup(name string) (*landb.EGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEGroup", name)
+	ret0, _ := ret[0].(*landb.EGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEGroup indicates an expected call of GetEGroup.
+func (mr *MockAPIMockRecorder) GetEGroup(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEGroup", reflect.TypeOf((*MockAPI)(nil).GetEGroup), name)
+}
+
+// GetInterface mocks base method.
+func (m *MockAPI) GetInterface(deviceName, name string) (*landb.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInterface", deviceName, name)
+	ret0, _ := ret[0].(*landb.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInterface indicates an expected call of GetInterface.
+func (mr *MockAPIMockRecorder) GetInterface(deviceName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterface", reflect.TypeOf((*MockAPI)(nil).GetInterface), deviceName, name)
+}
+
+// GetPerson mocks base method.
+func (m *MockAPI) GetPerson(username string) (*landb.Person, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPerson", username)
+	ret0, _ := ret[0].(*landb.Person)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPerson indicates an expected call of GetPerson.
+func (mr *MockAPIMockRecorder) GetPerson(username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPerson", reflect.TypeOf((*MockAPI)(nil).GetPerson), username)
+}
+
+// GetService mocks base method.
+func (m *MockAPI) GetService(name string) (*landb.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetService", name)
+	ret0, _ := ret[0].(*landb.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetService indicates an expected call of GetService.
+func (mr *MockAPIMockRecorder) GetService(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetService", reflect.TypeOf((*MockAPI)(nil).GetService), name)
+}
+
+// GetSet mocks base method.
+func (m *MockAPI) GetSet(name string) (*landb.Set, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSet", name)
+	ret0, _ := ret[0].(*landb.Set)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSet indicates an expected call of GetSet.
+func (mr *MockAPIMockRecorder) GetSet(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSet", reflect.TypeOf((*MockAPI)(nil).GetSet), name)
+}
+
+// GetSetAttachments mocks base method.
+func (m *MockAPI) GetSetAttachments(setName string) ([]landb.SetAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSetAttachments", setName)
+	ret0, _ := ret[0].([]landb.SetAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSetAttachments indicates an expected call of GetSetAttachments.
+func (mr *MockAPIMockRecorder) GetSetAttachments(setName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSetAttachments", reflect.TypeOf((*MockAPI)(nil).GetSetAttachments), setName)
+}
+
+// GetSubnet mocks base method.
+func (m *MockAPI) GetSubnet(name string) (*landb.Subnet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnet", name)
+	ret0, _ := ret[0].(*landb.Subnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnet indicates an expected call of GetSubnet.
+func (mr *MockAPIMockRecorder) GetSubnet(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnet", reflect.TypeOf((*MockAPI)(nil).GetSubnet), name)
+}
+
+// ListLocations mocks base method.
+func (m *MockAPI) ListLocations(building string) ([]landb.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocations", building)
+	ret0, _ := ret[0].([]landb.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocations indicates an expected call of ListLocations.
+func (mr *MockAPIMockRecorder) ListLocations(building any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocations", reflect.TypeOf((*MockAPI)(nil).ListLocations), building)
+}
+
+// ListManufacturers mocks base method.
+func (m *MockAPI) ListManufacturers() ([]landb.Manufacturer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListManufacturers")
+	ret0, _ := ret[0].([]landb.Manufacturer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListManufacturers indicates an expected call of ListManufacturers.
+func (mr *MockAPIMockRecorder) ListManufacturers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManufacturers", reflect.TypeOf((*MockAPI)(nil).ListManufacturers))
+}
+
+// ListModels mocks base method.
+func (m *MockAPI) ListModels(manufacturer string) ([]landb.Model, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModels", manufacturer)
+	ret0, _ := ret[0].([]landb.Model)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModels indicates an expected call of ListModels.
+func (mr *MockAPIMockRecorder) ListModels(manufacturer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModels", reflect.TypeOf((*MockAPI)(nil).ListModels), manufacturer)
+}
+
+// ListOperatingSystems mocks base method.
+func (m *MockAPI) ListOperatingSystems(family string) ([]landb.OperatingSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOperatingSystems", family)
+	ret0, _ := ret[0].([]landb.OperatingSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOperatingSystems indicates an expected call of ListOperatingSystems.
+func (mr *MockAPIMockRecorder) ListOperatingSystems(family any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOperatingSystems", reflect.TypeOf((*MockAPI)(nil).ListOperatingSystems), family)
+}
+
+// ListZones mocks base method.
+func (m *MockAPI) ListZones() ([]landb.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListZones")
+	ret0, _ := ret[0].([]landb.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListZones indicates an expected call of ListZones.
+func (mr *MockAPIMockRecorder) ListZones() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZones", reflect.TypeOf((*MockAPI)(nil).ListZones))
+}
+
+// Token mocks base method.
+func (m *MockAPI) Token() (*landb.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token")
+	ret0, _ := ret[0].(*landb.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockAPIMockRecorder) Token() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockAPI)(nil).Token))
+}
+
+// UpdateDNSAlias mocks base method.
+func (m *MockAPI) UpdateDNSAlias(name string, alias landb.DNSAlias) (*landb.DNSAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDNSAlias", name, alias)
+	ret0, _ := ret[0].(*landb.DNSAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDNSAlias indicates an expected call of UpdateDNSAlias.
+func (mr *MockAPIMockRecorder) UpdateDNSAlias(name, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDNSAlias", reflect.TypeOf((*MockAPI)(nil).UpdateDNSAlias), name, alias)
+}
+
+// UpdateDevice mocks base method.
+func (m *MockAPI) UpdateDevice(name string, device landb.Device) (*landb.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDevice", name, device)
+	ret0, _ := ret[0].(*landb.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDevice indicates an expected call of UpdateDevice.
+func (mr *MockAPIMockRecorder) UpdateDevice(name, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDevice", reflect.TypeOf((*MockAPI)(nil).UpdateDevice), name, device)
+}
+
+// UpdateInterface mocks base method.
+func (m *MockAPI) UpdateInterface(deviceName, name string, iface landb.Interface) (*landb.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInterface", deviceName, name, iface)
+	ret0, _ := ret[0].(*landb.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInterface indicates an expected call of UpdateInterface.
+func (mr *MockAPIMockRecorder) UpdateInterface(deviceName, name, iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInterface", reflect.TypeOf((*MockAPI)(nil).UpdateInterface), deviceName, name, iface)
+}
+
+// UpdateSet mocks base method.
+func (m *MockAPI) UpdateSet(name string, set landb.Set) (*landb.Set, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSet", name, set)
+	ret0, _ := ret[0].(*landb.Set)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSet indicates an expected call of UpdateSet.
+func (mr *MockAPIMockRecorder) UpdateSet(name, set any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSet", reflect.TypeOf((*MockAPI)(nil).UpdateSet), name, set)
+}
+
+// UpdateSetAttachment mocks base method.
+func (m *MockAPI) UpdateSetAttachment(setName, attachmentName string, att landb.SetAttachment) (*landb.SetAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSetAttachment", setName, attachmentName, att)
+	ret0, _ := ret[0].(*landb.SetAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSetAttachment indicates an expected call of UpdateSetAttachment.
+func (mr *MockAPIMockRecorder) UpdateSetAttachment(setName, attachmentName, att any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSetAttachment", reflect.TypeOf((*MockAPI)(nil).UpdateSetAttachment), setName, attachmentName, att)
+}
+
+// WithChangeComment mocks base method.
+func (m *MockAPI) WithChangeComment(comment string) landb.API {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithChangeComment", comment)
+	ret0, _ := ret[0].(landb.API)
+	return ret0
+}
+
+// WithChangeComment indicates an expected call of WithChangeComment.
+func (mr *MockAPIMockRecorder) WithChangeComment(comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithChangeComment", reflect.TypeOf((*MockAPI)(nil).WithChangeComment), comment)
+}
+
+// WithContext mocks base method.
+func (m *MockAPI) WithContext(ctx context.Context) landb.API {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithContext", ctx)
+	ret0, _ := ret[0].(landb.API)
+	return ret0
+}
+
+// WithContext indicates an expected call of WithContext.
+func (mr *MockAPIMockRecorder) WithContext(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*MockAPI)(nil).WithContext), ctx)
+}
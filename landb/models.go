@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import "github.com/barnes-c/terraform-provider-landb/landb/landbapi"
+
+// The models are generated from the OpenAPI document of LanDB into package
+// landbapi. Run `make generate` after updating landbapi/openapi.yaml to pick
+// up new fields.
+type (
+	// APIError is the error body returned by LanDB.
+	APIError = landbapi.APIError
+
+	Device          = landbapi.Device
+	IPAddress       = landbapi.IPAddress
+	Interface       = landbapi.Interface
+	DNSAlias        = landbapi.DNSAlias
+	Set             = landbapi.Set
+	SetAttachment   = landbapi.SetAttachment
+	Location        = landbapi.Location
+	OperatingSystem = landbapi.OperatingSystem
+	Contact         = landbapi.Contact
+	Person          = landbapi.Person
+	EGroup          = landbapi.EGroup
+	Reserved        = landbapi.Reserved
+	Zone            = landbapi.Zone
+	Manufacturer    = landbapi.Manufacturer
+	Model           = landbapi.Model
+	Service         = landbapi.Service
+	Subnet          = landbapi.Subnet
+)
@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// The OpenID Connect endpoints are variables so tests can point them at a
+// local server.
+var (
+	deviceAuthURL = "https://auth.cern.ch/auth/realms/cern/protocol/openid-connect/auth/device"
+	tokenURL      = "https://auth.cern.ch/auth/realms/cern/protocol/openid-connect/token"
+)
+
+const (
+	grantTypeDeviceCode    = "urn:ietf:params:oauth:grant-type:device_code"
+	grantTypeTokenExchange = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeAccessToken   = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+// DeviceAuthorization is the response of the device authorization endpoint.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+type oauthError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// DeviceCodeSource obtains tokens with the OAuth 2.0 device authorization
+// grant, see AuthenticateDeviceCode.
+type DeviceCodeSource struct {
+	ClientID string
+	Audience string
+	Prompt   func(DeviceAuthorization)
+}
+
+func (s DeviceCodeSource) Token(ctx context.Context, client *resty.Client) (*AuthResponse, error) {
+	return authenticateDeviceCode(ctx, client, s.ClientID, s.Audience, s.Prompt)
+}
+
+// TokenExchangeSource exchanges SubjectToken for tokens issued to Audience.
+// ClientSecret may be empty for public clients.
+type TokenExchangeSource struct {
+	ClientID     string
+	ClientSecret string
+	SubjectToken string
+	Audience     string
+}
+
+func (s TokenExchangeSource) Token(ctx context.Context, client *resty.Client) (*AuthResponse, error) {
+	return exchangeToken(ctx, client, tokenURL, s.ClientID, s.ClientSecret, s.SubjectToken, s.Audience)
+}
+
+// AuthenticateDeviceCode runs the OAuth 2.0 device authorization grant for
+// the public client clientID. prompt is called once with the URL and code the
+// user has to enter; the token endpoint is then polled until the user has
+// logged in or ctx is done, and the resulting token is exchanged for one
+// issued to audience.
+func AuthenticateDeviceCode(ctx context.Context, clientID, audience string, prompt func(DeviceAuthorization)) (*AuthResponse, error) {
+	return authenticateDeviceCode(ctx, resty.New(), clientID, audience, prompt)
+}
+
+func authenticateDeviceCode(ctx context.Context, client *resty.Client, clientID, audience string, prompt func(DeviceAuthorization)) (*AuthResponse, error) {
+	var auth DeviceAuthorization
+	resp, err := client.R().
+		SetContext(ctx).
+		SetFormData(map[string]string{
+			"client_id": clientID,
+		}).
+		SetResult(&auth).
+		Post(deviceAuthURL)
+	if err != nil {
+		return nil, fmt.Errorf("device authorization request failed: %w", err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("device authorization error [%d]: %s", resp.StatusCode(), resp.String())
+	}
+
+	prompt(auth)
+
+	interval := time.Duration(auth.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	deadline := time.Now().Add(time.Duration(auth.ExpiresIn) * time.Second)
+
+	for {
+		if auth.ExpiresIn > 0 && time.Now().After(deadline) {
+			return nil, fmt.Errorf("device code expired before the login was completed")
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for the device login: %w", ctx.Err())
+		case <-time.After(interval):
+		}
+
+		var userResp AuthResponse
+		var oauthErr oauthError
+		resp, err := client.R().
+			SetContext(ctx).
+			SetFormData(map[string]string{
+				"grant_type":  grantTypeDeviceCode,
+				"client_id":   clientID,
+				"device_code": auth.DeviceCode,
+			}).
+			SetResult(&userResp).
+			SetError(&oauthErr).
+			Post(tokenURL)
+		if err != nil {
+			return nil, fmt.Errorf("token request failed: %w", err)
+		}
+
+		if resp.IsError() {
+			switch oauthErr.Error {
+			case "authorization_pending":
+				continue
+			case "slow_down":
+				interval += 5 * time.Second
+				continue
+			}
+			return nil, fmt.Errorf("token error [%d]: %s", resp.StatusCode(), resp.String())
+		}
+
+		return exchangeToken(ctx, client, tokenURL, clientID, "", userResp.AccessToken, audience)
+	}
+}
+
+// AuthenticateTokenExchange exchanges an existing user token for one issued
+// to audience following RFC 8693. clientSecret may be empty for public
+// clients.
+func AuthenticateTokenExchange(clientID, clientSecret, subjectToken, audience string) (*AuthResponse, error) {
+	return exchangeToken(context.Background(), resty.New(), tokenURL, clientID, clientSecret, subjectToken, audience)
+}
+
+func exchangeToken(ctx context.Context, client *resty.Client, url, clientID, clientSecret, subjectToken, audience string) (*AuthResponse, error) {
+	form := map[string]string{
+		"grant_type":         grantTypeTokenExchange,
+		"client_id":          clientID,
+		"subject_token":      subjectToken,
+		"subject_token_type": tokenTypeAccessToken,
+		"audience":           audience,
+	}
+	if clientSecret != "" {
+		form["client_secret"] = clientSecret
+	}
+
+	var authResp AuthResponse
+	resp, err := client.R().
+		SetContext(ctx).
+		SetFormData(form).
+		SetResult(&authResp).
+		Post(url)
+	if err != nil {
+		return nil, fmt.Errorf("token exchange request failed: %w", err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("token exchange error [%d]: %s", resp.StatusCode(), resp.String())
+	}
+
+	return &authResp, nil
+}
+
+// tokenCacheMargin is how long before its expiry a cached token is no longer
+// handed out.
+const tokenCacheMargin = 30 * time.Second
+
+type cachedToken struct {
+	AuthResponse
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// tokenCacheKey identifies the tokens source obtains for clientID and
+// audience. It covers the grant and, for token exchange, a hash of the
+// subject token, so that tokens of another grant or subject are not reused.
+func tokenCacheKey(clientID, audience string, source TokenSource) string {
+	var grant string
+	switch s := source.(type) {
+	case DeviceCodeSource:
+		grant = "device_code"
+	case TokenExchangeSource:
+		sum := sha256.Sum256([]byte(s.SubjectToken))
+		grant = "token_exchange\x00" + hex.EncodeToString(sum[:])
+	default:
+		grant = fmt.Sprintf("%T", source)
+	}
+
+	return clientID + "\x00" + audience + "\x00" + grant
+}
+
+// tokenCachePath returns the file caching the tokens of key below the user's
+// cache directory.
+func tokenCachePath(key string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(dir, "terraform-provider-landb", hex.EncodeToString(sum[:16])+".json"), nil
+}
+
+func loadCachedToken(key string) *AuthResponse {
+	path, err := tokenCachePath(key)
+	if err != nil {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var token cachedToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil
+	}
+	if time.Until(token.ExpiresAt) < tokenCacheMargin {
+		return nil
+	}
+
+	return &token.AuthResponse
+}
+
+func storeCachedToken(key string, authResp *AuthResponse) error {
+	path, err := tokenCachePath(key)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cachedToken{
+		AuthResponse: *authResp,
+		ExpiresAt:    time.Now().Add(time.Duration(authResp.ExpiresIn) * time.Second),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o600)
+}
+
+// ErrTokenNotCached is returned along with a valid token that could not be
+// written to the token cache.
+var ErrTokenNotCached = errors.New("token not cached")
+
+type cachedTokenSource struct {
+	mu        sync.Mutex
+	key       string
+	source    TokenSource
+	token     *AuthResponse
+	expiresAt time.Time
+}
+
+// CachedTokenSource wraps source so its tokens are cached on disk, keyed by
+// clientID, audience and the grant of source, and reused until they expire.
+// A token that cannot be written to disk is returned with an error wrapping
+// ErrTokenNotCached and kept in memory instead.
+func CachedTokenSource(clientID, audience string, source TokenSource) TokenSource {
+	return &cachedTokenSource{key: tokenCacheKey(clientID, audience, source), source: source}
+}
+
+func (s *cachedTokenSource) Token(ctx context.Context, client *resty.Client) (*AuthResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && time.Until(s.expiresAt) >= tokenCacheMargin {
+		return s.token, nil
+	}
+	if token := loadCachedToken(s.key); token != nil {
+		return token, nil
+	}
+
+	authResp, err := s.source.Token(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := storeCachedToken(s.key, authResp); err != nil {
+		s.token = authResp
+		s.expiresAt = time.Now().Add(time.Duration(authResp.ExpiresIn) * time.Second)
+		return authResp, fmt.Errorf("%w: %w", ErrTokenNotCached, err)
+	}
+
+	return authResp, nil
+}
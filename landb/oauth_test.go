@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOAuthServer(t *testing.T) *httptest.Server {
+	pending := true
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "landb-cli", r.PostForm.Get("client_id"))
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(DeviceAuthorization{
+			DeviceCode:      "device-code",
+			UserCode:        "ABCD-EFGH",
+			VerificationURI: "https://auth.example.org/device",
+			ExpiresIn:       60,
+			Interval:        1,
+		}))
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		w.Header().Set("Content-Type", "application/json")
+
+		var token string
+		switch r.PostForm.Get("grant_type") {
+		case grantTypeDeviceCode:
+			require.Equal(t, "device-code", r.PostForm.Get("device_code"))
+			if pending {
+				pending = false
+				w.WriteHeader(http.StatusBadRequest)
+				require.NoError(t, json.NewEncoder(w).Encode(oauthError{Error: "authorization_pending"}))
+				return
+			}
+			token = "user-token"
+		case grantTypeTokenExchange:
+			require.Equal(t, "user-token", r.PostForm.Get("subject_token"))
+			require.Equal(t, tokenTypeAccessToken, r.PostForm.Get("subject_token_type"))
+			require.Equal(t, "production-microservice-landb-rest", r.PostForm.Get("audience"))
+			token = "landb-token"
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(AuthResponse{AccessToken: token, ExpiresIn: 300, TokenType: "Bearer"}))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	oldDeviceAuthURL, oldTokenURL := deviceAuthURL, tokenURL
+	deviceAuthURL, tokenURL = server.URL+"/device", server.URL+"/token"
+	t.Cleanup(func() { deviceAuthURL, tokenURL = oldDeviceAuthURL, oldTokenURL })
+
+	return server
+}
+
+func TestAuthenticateDeviceCode(t *testing.T) {
+	newOAuthServer(t)
+
+	var prompted DeviceAuthorization
+	authResp, err := AuthenticateDeviceCode(context.Background(), "landb-cli", "production-microservice-landb-rest", func(auth DeviceAuthorization) {
+		prompted = auth
+	})
+	require.NoError(t, err)
+	require.Equal(t, "landb-token", authResp.AccessToken)
+	require.Equal(t, "ABCD-EFGH", prompted.UserCode)
+}
+
+func TestAuthenticateDeviceCodeCanceled(t *testing.T) {
+	newOAuthServer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := AuthenticateDeviceCode(ctx, "landb-cli", "production-microservice-landb-rest", func(DeviceAuthorization) {
+		cancel()
+	})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestAuthenticateTokenExchange(t *testing.T) {
+	newOAuthServer(t)
+
+	authResp, err := AuthenticateTokenExchange("landb-cli", "", "user-token", "production-microservice-landb-rest")
+	require.NoError(t, err)
+	require.Equal(t, "landb-token", authResp.AccessToken)
+}
+
+func TestTokenCache(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	source := &fakeTokenSource{authResp: AuthResponse{AccessToken: "landb-token", ExpiresIn: 300}}
+	cached := CachedTokenSource("landb-cli", "production-microservice-landb-rest", source)
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			authResp, err := cached.Token(context.Background(), resty.New())
+			assert.NoError(t, err)
+			assert.Equal(t, "landb-token", authResp.AccessToken)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, source.calls)
+
+	require.Nil(t, loadCachedToken(tokenCacheKey("landb-cli", "other-audience", source)))
+
+	shortLived := &fakeTokenSource{authResp: AuthResponse{AccessToken: "short-token", ExpiresIn: 1}}
+	_, err := CachedTokenSource("landb-cli", "short-lived", shortLived).Token(context.Background(), resty.New())
+	require.NoError(t, err)
+	require.Nil(t, loadCachedToken(tokenCacheKey("landb-cli", "short-lived", shortLived)))
+}
+
+func TestTokenCacheKey(t *testing.T) {
+	exchange := func(subjectToken string) TokenSource {
+		return TokenExchangeSource{ClientID: "landb-cli", SubjectToken: subjectToken, Audience: "production-microservice-landb-rest"}
+	}
+	deviceCode := DeviceCodeSource{ClientID: "landb-cli", Audience: "production-microservice-landb-rest"}
+
+	key := func(source TokenSource) string {
+		return tokenCacheKey("landb-cli", "production-microservice-landb-rest", source)
+	}
+	require.Equal(t, key(exchange("user-token")), key(exchange("user-token")))
+	require.NotEqual(t, key(exchange("user-token")), key(exchange("other-user-token")))
+	require.NotEqual(t, key(exchange("user-token")), key(deviceCode))
+	require.NotContains(t, key(exchange("user-token")), "user-token")
+}
+
+func TestTokenCacheUnwritable(t *testing.T) {
+	cacheFile := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(cacheFile, nil, 0o600))
+	t.Setenv("XDG_CACHE_HOME", cacheFile)
+	t.Setenv("HOME", cacheFile)
+
+	source := &fakeTokenSource{authResp: AuthResponse{AccessToken: "landb-token", ExpiresIn: 300}}
+	cached := CachedTokenSource("landb-cli", "production-microservice-landb-rest", source)
+	authResp, err := cached.Token(context.Background(), resty.New())
+	require.ErrorIs(t, err, ErrTokenNotCached)
+	require.Equal(t, "landb-token", authResp.AccessToken)
+
+	authResp, err = cached.Token(context.Background(), resty.New())
+	require.NoError(t, err)
+	require.Equal(t, "landb-token", authResp.AccessToken)
+	require.Equal(t, 1, source.calls)
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Option configures a Client created by New.
+type Option func(*Client) error
+
+// New creates a client for the production LanDB API that sends requests
+// without a token, adjusted by opts.
+func New(opts ...Option) (*Client, error) {
+	httpClient := resty.New()
+
+	client := &Client{
+		HTTPClient: httpClient,
+		authClient: resty.NewWithClient(&http.Client{Transport: httpClient.GetClient().Transport}),
+		baseURL:    DefaultEndpoint,
+	}
+	client.SetUserAgent(defaultUserAgent)
+	client.HTTPClient.OnRequestLog(redactRequestLog)
+
+	client.HTTPClient.OnBeforeRequest(func(c *resty.Client, r *resty.Request) error {
+		if r.Header.Get(RequestIDHeader) == "" {
+			r.SetHeader(RequestIDHeader, newRequestID())
+		}
+
+		// A token set on the request, e.g. by DetectAPIVersions, is kept.
+		if client.tokenSource == nil || r.Token != "" {
+			return nil
+		}
+
+		authResp, err := client.tokenSource.Token(r.Context(), client.authClient)
+		if err != nil && !errors.Is(err, ErrTokenNotCached) {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+		r.SetAuthToken(authResp.AccessToken)
+
+		return nil
+	})
+
+	for _, opt := range opts {
+		if err := opt(client); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// WithEndpoint sets the base URL of the LanDB API, e.g.
+// https://landb.cern.ch/api/. An empty endpoint keeps DefaultEndpoint.
+func WithEndpoint(endpoint string) Option {
+	return func(c *Client) error {
+		if endpoint == "" {
+			return nil
+		}
+		if !strings.HasSuffix(endpoint, "/") {
+			endpoint += "/"
+		}
+		c.baseURL = endpoint
+		return nil
+	}
+}
+
+// WithTokenSource authenticates every request with a token of source.
+func WithTokenSource(source TokenSource) Option {
+	return func(c *Client) error {
+		c.tokenSource = source
+		return nil
+	}
+}
+
+// WithClientCredentials authenticates with the secret of an SSO
+// application.
+func WithClientCredentials(clientID, clientSecret, audience string) Option {
+	return WithTokenSource(ClientCredentialsSource{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Audience:     audience,
+	})
+}
+
+// WithClientCertificate authenticates with a TLS client certificate instead
+// of an SSO token.
+func WithClientCertificate(certPEM, keyPEM []byte) Option {
+	return func(c *Client) error {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("invalid client certificate: %w", err)
+		}
+
+		transport, err := c.HTTPClient.Transport()
+		if err != nil {
+			return err
+		}
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+
+		return nil
+	}
+}
+
+// WithHTTPOptions configures the transport of the API and SSO requests.
+func WithHTTPOptions(opts HTTPOptions) Option {
+	return func(c *Client) error {
+		return c.SetHTTPOptions(opts)
+	}
+}
+
+// WithUserAgent sets the User-Agent header of the API and SSO requests.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		c.SetUserAgent(userAgent)
+		return nil
+	}
+}
+
+// WithDebug logs every request and response, see SetDebug.
+func WithDebug(debug bool) Option {
+	return func(c *Client) error {
+		c.SetDebug(debug)
+		return nil
+	}
+}
+
+// WithAPIVersion sends requests to version of the API, e.g. APIVersionV1,
+// unless WithEndpointVersion sets another one for their endpoint.
+func WithAPIVersion(version string) Option {
+	return func(c *Client) error {
+		c.SetAPIVersion(version)
+		return nil
+	}
+}
+
+// WithEndpointVersion sends the requests of endpoint, e.g. "sets", to
+// version of the API.
+func WithEndpointVersion(endpoint, version string) Option {
+	return func(c *Client) error {
+		c.SetEndpointVersion(endpoint, version)
+		return nil
+	}
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/stretchr/testify/require"
+)
+
+func ExampleNew() {
+	client, err := landb.New(
+		landb.WithClientCredentials("my-application", "my-secret", "production-microservice-landb-rest"),
+		landb.WithUserAgent("inventory-reconciler/1.0"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	device, err := client.GetDevice("MY-DEVICE")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(device.Zone)
+}
+
+func TestNewWithEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/beta/devices/TEST-DEVICE", r.URL.Path)
+		require.Equal(t, "Bearer landb-token", r.Header.Get("Authorization"))
+		require.Equal(t, "landb-test", r.Header.Get("User-Agent"))
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(landb.Device{Name: "TEST-DEVICE", Zone: "TEST-ZONE"}))
+	}))
+	defer server.Close()
+
+	var api landb.API
+	api, err := landb.New(
+		landb.WithEndpoint(server.URL+"/api"),
+		landb.WithTokenSource(landb.StaticTokenSource{AccessToken: "landb-token"}),
+		landb.WithUserAgent("landb-test"),
+	)
+	require.NoError(t, err)
+
+	device, err := api.GetDevice("TEST-DEVICE")
+	require.NoError(t, err)
+	require.Equal(t, "TEST-ZONE", device.Zone)
+}
+
+func TestNewWithInvalidCertificate(t *testing.T) {
+	_, err := landb.New(landb.WithClientCertificate([]byte("not a certificate"), []byte("not a key")))
+	require.Error(t, err)
+}
+
+// debugLogger records the debug log of resty.
+type debugLogger struct {
+	strings.Builder
+}
+
+func (l *debugLogger) Errorf(format string, v ...any) { fmt.Fprintf(l, format, v...) }
+func (l *debugLogger) Warnf(format string, v ...any)  { fmt.Fprintf(l, format, v...) }
+func (l *debugLogger) Debugf(format string, v ...any) { fmt.Fprintf(l, format, v...) }
+
+func TestNewWithDebug(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(landb.Device{Name: "TEST-DEVICE"}))
+	}))
+	defer server.Close()
+
+	for _, debug := range []bool{false, true} {
+		client, err := landb.NewClientWithTokenSource(server.URL, landb.StaticTokenSource{AccessToken: "landb-token"})
+		require.NoError(t, err)
+		if debug {
+			client.SetDebug(true)
+		}
+
+		var log debugLogger
+		client.HTTPClient.SetLogger(&log)
+
+		_, err = client.GetDevice("TEST-DEVICE")
+		require.NoError(t, err)
+		require.NotContains(t, log.String(), "landb-token")
+		if debug {
+			require.Contains(t, log.String(), "Authorization: REDACTED")
+		} else {
+			require.Empty(t, log.String())
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"fmt"
+)
+
+const (
+	locationsURL        = "locations"
+	zonesURL            = "zones"
+	operatingSystemsURL = "operating-systems"
+	manufacturersURL    = "manufacturers"
+	modelsURL           = "models"
+)
+
+// ListLocations returns the known locations, optionally restricted to a
+// single building when building is not empty.
+func (c *Client) ListLocations(building string) ([]Location, error) {
+	url := c.endpointURL(locationsURL)
+
+	var result []Location
+	var apiErr APIError
+
+	req := c.request().
+		SetResult(&result).
+		SetError(&apiErr)
+	if building != "" {
+		req.SetQueryParam("building", building)
+	}
+
+	resp, err := req.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("list locations failed: %s", apiErr.Message)
+	}
+	return result, nil
+}
+
+func (c *Client) ListZones() ([]Zone, error) {
+	url := c.endpointURL(zonesURL)
+
+	var result []Zone
+	var apiErr APIError
+
+	resp, err := c.request().
+		SetResult(&result).
+		SetError(&apiErr).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("list zones failed: %s", apiErr.Message)
+	}
+	return result, nil
+}
+
+// ListOperatingSystems returns the known operating systems, optionally
+// restricted to a single family when family is not empty.
+func (c *Client) ListOperatingSystems(family string) ([]OperatingSystem, error) {
+	url := c.endpointURL(operatingSystemsURL)
+
+	var result []OperatingSystem
+	var apiErr APIError
+
+	req := c.request().
+		SetResult(&result).
+		SetError(&apiErr)
+	if family != "" {
+		req.SetQueryParam("family", family)
+	}
+
+	resp, err := req.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("list operating systems failed: %s", apiErr.Message)
+	}
+	return result, nil
+}
+
+func (c *Client) ListManufacturers() ([]Manufacturer, error) {
+	url := c.endpointURL(manufacturersURL)
+
+	var result []Manufacturer
+	var apiErr APIError
+
+	resp, err := c.request().
+		SetResult(&result).
+		SetError(&apiErr).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("list manufacturers failed: %s", apiErr.Message)
+	}
+	return result, nil
+}
+
+// ListModels returns the known device models, optionally restricted to a
+// single manufacturer when manufacturer is not empty.
+func (c *Client) ListModels(manufacturer string) ([]Model, error) {
+	url := c.endpointURL(modelsURL)
+
+	var result []Model
+	var apiErr APIError
+
+	req := c.request().
+		SetResult(&result).
+		SetError(&apiErr)
+	if manufacturer != "" {
+		req.SetQueryParam("manufacturer", manufacturer)
+	}
+
+	resp, err := req.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("list models failed: %s", apiErr.Message)
+	}
+	return result, nil
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferenceData(t *testing.T) {
+	apiEndpoint := "https://landb.cern.ch/api/"
+	clientID := "terraform-provider-landb"
+	clientSecret := os.Getenv("LANDB_SSO_CLIENT_SECRET")
+	audience := "production-microservice-landb-rest"
+	require.NotEmpty(t, clientSecret, "environment variable LANDB_SSO_CLIENT_SECRET must be set")
+
+	cli, err := landb.NewClient(apiEndpoint, clientID, clientSecret, audience)
+	require.NoError(t, err)
+
+	t.Log("Listing locations in building 31...")
+	locations, err := cli.ListLocations("31")
+	require.NoError(t, err)
+	require.NotEmpty(t, locations)
+	for _, l := range locations {
+		require.Equal(t, "31", l.Building)
+	}
+
+	t.Log("Listing zones...")
+	zones, err := cli.ListZones()
+	require.NoError(t, err)
+	require.NotEmpty(t, zones)
+
+	t.Log("Listing operating systems...")
+	systems, err := cli.ListOperatingSystems("LINUX")
+	require.NoError(t, err)
+	require.NotEmpty(t, systems)
+
+	t.Log("Listing manufacturers...")
+	manufacturers, err := cli.ListManufacturers()
+	require.NoError(t, err)
+	require.NotEmpty(t, manufacturers)
+
+	t.Log("Listing models...")
+	models, err := cli.ListModels(manufacturers[0].Name)
+	require.NoError(t, err)
+	for _, m := range models {
+		require.Equal(t, manufacturers[0].Name, m.Manufacturer)
+	}
+}
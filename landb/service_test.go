@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceLookup(t *testing.T) {
+	apiEndpoint := "https://landb.cern.ch/api/"
+	clientID := "terraform-provider-landb"
+	clientSecret := os.Getenv("LANDB_SSO_CLIENT_SECRET")
+	audience := "production-microservice-landb-rest"
+	require.NotEmpty(t, clientSecret, "environment variable LANDB_SSO_CLIENT_SECRET must be set")
+
+	cli, err := landb.NewClient(apiEndpoint, clientID, clientSecret, audience)
+	require.NoError(t, err)
+
+	t.Log("Finding services of building 31...")
+	services, err := cli.FindServices("31", "")
+	require.NoError(t, err)
+	require.NotEmpty(t, services)
+
+	t.Logf("Reading service: %s", services[0].Name)
+	service, err := cli.GetService(services[0].Name)
+	require.NoError(t, err)
+	require.Equal(t, services[0].Name, service.Name)
+	require.NotEmpty(t, service.Subnets)
+
+	t.Logf("Reading subnet: %s", service.Subnets[0].Name)
+	subnet, err := cli.GetSubnet(service.Subnets[0].Name)
+	require.NoError(t, err)
+	require.Equal(t, service.Name, subnet.Service)
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"fmt"
+)
+
+const (
+	servicesURL = "services/"
+	subnetsURL  = "subnets/"
+)
+
+func (c *Client) GetService(name string) (*Service, error) {
+	url := c.endpointURL(servicesURL + name)
+
+	var apiErr APIError
+	resp, err := c.request().
+		SetResult(&Service{}).
+		SetError(&apiErr).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("get service failed: %s", apiErr.Message)
+	}
+	return resp.Result().(*Service), nil
+}
+
+// FindServices returns the services serving the given building or outlet.
+// Empty arguments are not used as filters.
+func (c *Client) FindServices(building, outlet string) ([]Service, error) {
+	url := c.endpointURL(servicesURL)
+
+	var result []Service
+	var apiErr APIError
+
+	req := c.request().
+		SetResult(&result).
+		SetError(&apiErr)
+	if building != "" {
+		req.SetQueryParam("building", building)
+	}
+	if outlet != "" {
+		req.SetQueryParam("outlet", outlet)
+	}
+
+	resp, err := req.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("find services failed: %s", apiErr.Message)
+	}
+	return result, nil
+}
+
+func (c *Client) GetSubnet(name string) (*Subnet, error) {
+	url := c.endpointURL(subnetsURL + name)
+
+	var apiErr APIError
+	resp, err := c.request().
+		SetResult(&Subnet{}).
+		SetError(&apiErr).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("get subnet failed: %s", apiErr.Message)
+	}
+	return resp.Result().(*Subnet), nil
+}
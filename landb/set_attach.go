@@ -7,27 +7,17 @@ package landb
 import (
 	"fmt"
 	"net/http"
-	"time"
 )
 
-const setAttachmentURL = "beta/sets/%s/ip-addresses"
-
-type SetAttachment struct {
-	DeviceName  string    `json:"name"`
-	IPv4        string    `json:"ipv4"`
-	IPv6        string    `json:"ipv6"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"_createdAt,omitempty"`
-	UpdatedAt   time.Time `json:"_updatedAt,omitempty"`
-}
+const setAttachmentURL = "sets/%s/ip-addresses"
 
 func (c *Client) GetSetAttachments(setName string) ([]SetAttachment, error) {
-	url := fmt.Sprintf("%s"+setAttachmentURL, landbURL, setName)
+	url := c.endpointURL(fmt.Sprintf(setAttachmentURL, setName))
 
 	var result []SetAttachment
 	var apiErr APIError
 
-	resp, err := c.HTTPClient.R().
+	resp, err := c.request().
 		SetResult(&result).
 		SetError(&apiErr).
 		Get(url)
@@ -41,12 +31,12 @@ func (c *Client) GetSetAttachments(setName string) ([]SetAttachment, error) {
 }
 
 func (c *Client) CreateSetAttachment(setName string, att SetAttachment) (SetAttachment, error) {
-	url := fmt.Sprintf("%s"+setAttachmentURL, landbURL, setName)
+	url := c.endpointURL(fmt.Sprintf(setAttachmentURL, setName))
 
 	var result []SetAttachment
 	var apiErr APIError
 
-	resp, err := c.HTTPClient.R().
+	resp, err := c.writeRequest().
 		SetBody([]SetAttachment{att}).
 		SetResult(&result).
 		SetError(&apiErr).
@@ -61,10 +51,10 @@ func (c *Client) CreateSetAttachment(setName string, att SetAttachment) (SetAtta
 }
 
 func (c *Client) UpdateSetAttachment(setName, attachmentName string, att SetAttachment) (*SetAttachment, error) {
-	url := fmt.Sprintf("%s"+setAttachmentURL+"/%s", landbURL, setName, attachmentName)
+	url := c.endpointURL(fmt.Sprintf(setAttachmentURL+"/%s", setName, attachmentName))
 
 	var apiErr APIError
-	resp, err := c.HTTPClient.R().
+	resp, err := c.writeRequest().
 		SetBody(att).
 		SetResult(&SetAttachment{}).
 		SetError(&apiErr).
@@ -79,10 +69,10 @@ func (c *Client) UpdateSetAttachment(setName, attachmentName string, att SetAtta
 }
 
 func (c *Client) DeleteSetAttachment(setName, attachmentName string) error {
-	url := fmt.Sprintf("%s"+setAttachmentURL+"/%s", landbURL, setName, attachmentName)
+	url := c.endpointURL(fmt.Sprintf(setAttachmentURL+"/%s", setName, attachmentName))
 
 	var apiErr APIError
-	resp, err := c.HTTPClient.R().
+	resp, err := c.writeRequest().
 		SetError(&apiErr).
 		Delete(url)
 	if err != nil {
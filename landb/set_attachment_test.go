@@ -9,7 +9,7 @@ import (
 	"testing"
 	"time"
 
-	landb "landb/internal/client"
+	"github.com/barnes-c/terraform-provider-landb/landb"
 
 	"github.com/stretchr/testify/require"
 )
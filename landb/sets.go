@@ -8,26 +8,15 @@ import (
 	"fmt"
 )
 
-const setsURL = "beta/sets/"
-
-type Set struct {
-	Name                 string  `json:"name"`
-	Type                 string  `json:"type"`
-	NetworkDomain        string  `json:"networkDomain"`
-	Responsible          Contact `json:"responsible"`
-	Description          string  `json:"description"`
-	ProjectURL           string  `json:"projectUrl"`
-	ReceiveNotifications bool    `json:"receiveNotifications"`
-	Version              int     `json:"version"`
-}
+const setsURL = "sets/"
 
 func (c *Client) CreateSet(set Set) (Set, error) {
-	url := fmt.Sprintf("%s%s", landbURL, setsURL)
+	url := c.endpointURL(setsURL)
 
 	var result []Set
 	var apiErr APIError
 
-	resp, err := c.HTTPClient.R().
+	resp, err := c.writeRequest().
 		SetBody([]Set{set}).
 		SetResult(&result).
 		SetError(&apiErr).
@@ -42,10 +31,10 @@ func (c *Client) CreateSet(set Set) (Set, error) {
 }
 
 func (c *Client) GetSet(name string) (*Set, error) {
-	url := fmt.Sprintf("%s%s%s", landbURL, setsURL, name)
+	url := c.endpointURL(setsURL + name)
 
 	var apiErr APIError
-	resp, err := c.HTTPClient.R().
+	resp, err := c.request().
 		SetResult(&Set{}).
 		SetError(&apiErr).
 		Get(url)
@@ -59,10 +48,10 @@ func (c *Client) GetSet(name string) (*Set, error) {
 }
 
 func (c *Client) UpdateSet(name string, set Set) (*Set, error) {
-	url := fmt.Sprintf("%s%s%s", landbURL, setsURL, name)
+	url := c.endpointURL(setsURL + name)
 
 	var apiErr APIError
-	resp, err := c.HTTPClient.R().
+	resp, err := c.writeRequest().
 		SetBody(set).
 		SetResult(&Set{}).
 		SetError(&apiErr).
@@ -77,10 +66,10 @@ func (c *Client) UpdateSet(name string, set Set) (*Set, error) {
 }
 
 func (c *Client) DeleteSet(name string, version int) error {
-	url := fmt.Sprintf("%s%s%s", landbURL, setsURL, name)
+	url := c.endpointURL(setsURL + name)
 
 	var apiErr APIError
-	resp, err := c.HTTPClient.R().
+	resp, err := c.writeRequest().
 		SetQueryParam("version", fmt.Sprintf("%d", version)).
 		SetError(&apiErr).
 		Delete(url)
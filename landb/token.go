@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// TokenExpiry returns the expiry time stored in the exp claim of a JWT
+// access token. The signature is not verified.
+func TokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("access token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decoding access token payload: %w", err)
+	}
+
+	var claims struct {
+		Exp *int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("decoding access token claims: %w", err)
+	}
+	if claims.Exp == nil {
+		return time.Time{}, errors.New("access token has no exp claim")
+	}
+
+	return time.Unix(*claims.Exp, 0), nil
+}
+
+// StaticTokenSource hands out AccessToken as is. It fails once a JWT token
+// has expired.
+type StaticTokenSource struct {
+	AccessToken string
+}
+
+func (s StaticTokenSource) Token(context.Context, *resty.Client) (*AuthResponse, error) {
+	authResp := &AuthResponse{AccessToken: s.AccessToken, TokenType: "Bearer"}
+
+	expiry, err := TokenExpiry(s.AccessToken)
+	if err != nil {
+		return authResp, nil
+	}
+	if time.Now().After(expiry) {
+		return nil, fmt.Errorf("access token expired at %s", expiry.Format(time.RFC3339))
+	}
+	authResp.ExpiresIn = int(time.Until(expiry).Seconds())
+
+	return authResp, nil
+}
+
+// NewStaticTokenClient creates a client that sends accessToken as is instead
+// of obtaining tokens itself.
+func NewStaticTokenClient(apiURL, accessToken string) (*Client, error) {
+	return NewClientWithTokenSource(apiURL, StaticTokenSource{AccessToken: accessToken})
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTokenSource hands out authResp and records the HTTP client it was
+// called with.
+type fakeTokenSource struct {
+	authResp AuthResponse
+	err      error
+	calls    int
+	client   *resty.Client
+}
+
+func (s *fakeTokenSource) Token(_ context.Context, client *resty.Client) (*AuthResponse, error) {
+	s.calls++
+	s.client = client
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	authResp := s.authResp
+	return &authResp, nil
+}
+
+func TestClientTokenSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer server.Close()
+
+	source := &fakeTokenSource{authResp: AuthResponse{AccessToken: "landb-token"}}
+	cli, err := NewClientWithTokenSource(server.URL, source)
+	require.NoError(t, err)
+
+	resp, err := cli.HTTPClient.R().Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer landb-token", resp.String())
+	require.Equal(t, 1, source.calls)
+
+	apiTransport, err := cli.HTTPClient.Transport()
+	require.NoError(t, err)
+	require.Same(t, apiTransport, source.client.GetClient().Transport, "SSO requests share the API transport")
+
+	source.err = errors.New("no credentials")
+	_, err = cli.HTTPClient.R().Get(server.URL)
+	require.ErrorContains(t, err, "no credentials")
+}
+
+func TestClientWithoutTokenSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer server.Close()
+
+	cli, err := NewClientWithTokenSource(server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := cli.HTTPClient.R().Get(server.URL)
+	require.NoError(t, err)
+	require.Empty(t, resp.String())
+
+	_, err = cli.Token()
+	require.Error(t, err)
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/barnes-c/terraform-provider-landb/landb"
+
+	"github.com/stretchr/testify/require"
+)
+
+func jwt(claims string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." + enc.EncodeToString([]byte(claims)) + ".sig"
+}
+
+func TestTokenExpiry(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	expiry, err := landb.TokenExpiry(jwt(fmt.Sprintf(`{"exp":%d}`, exp.Unix())))
+	require.NoError(t, err)
+	require.True(t, exp.Equal(expiry))
+
+	_, err = landb.TokenExpiry(jwt(`{"sub":"landb"}`))
+	require.Error(t, err)
+
+	_, err = landb.TokenExpiry("opaque-token")
+	require.Error(t, err)
+}
+
+func TestStaticTokenClient(t *testing.T) {
+	valid := jwt(fmt.Sprintf(`{"exp":%d}`, time.Now().Add(time.Hour).Unix()))
+	cli, err := landb.NewStaticTokenClient("https://landb.cern.ch/api/", valid)
+	require.NoError(t, err)
+
+	authResp, err := cli.Token()
+	require.NoError(t, err)
+	require.Equal(t, valid, authResp.AccessToken)
+	require.Positive(t, authResp.ExpiresIn)
+
+	expired := jwt(fmt.Sprintf(`{"exp":%d}`, time.Now().Add(-time.Hour).Unix()))
+	cli, err = landb.NewStaticTokenClient("https://landb.cern.ch/api/", expired)
+	require.NoError(t, err)
+
+	_, err = cli.Token()
+	require.ErrorContains(t, err, "access token expired")
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Versions of the LanDB API.
+const (
+	APIVersionBeta = "beta"
+	APIVersionV1   = "v1"
+)
+
+// APIVersions lists the versions accepted by SetAPIVersion.
+var APIVersions = []string{APIVersionBeta, APIVersionV1}
+
+// endpoints lists the collections the client talks to with the path below
+// the version that DetectAPIVersions probes, which is the one the client
+// requests. An endpoint is the first segment of a path below the version, so
+// interfaces share the version of devices and set attachments that of sets.
+var endpoints = []struct {
+	name string
+	path string
+}{
+	{"devices", devicesURL},
+	{"dns-aliases", dnsAliasesURL},
+	{"sets", setsURL},
+	{"persons", personsURL},
+	{"egroups", egroupsURL},
+	{"locations", locationsURL},
+	{"zones", zonesURL},
+	{"operating-systems", operatingSystemsURL},
+	{"manufacturers", manufacturersURL},
+	{"models", modelsURL},
+	{"services", servicesURL},
+	{"subnets", subnetsURL},
+}
+
+// SetAPIVersion sets the version of the endpoints that have no version of
+// their own.
+func (c *Client) SetAPIVersion(version string) {
+	c.apiVersion = version
+}
+
+// SetEndpointVersion sends the requests of endpoint, e.g. "sets", to
+// version regardless of the version set with SetAPIVersion.
+func (c *Client) SetEndpointVersion(endpoint, version string) {
+	if c.versions == nil {
+		c.versions = map[string]string{}
+	}
+	c.versions[endpoint] = version
+}
+
+// EndpointVersion returns the version the requests of endpoint are sent to.
+func (c *Client) EndpointVersion(endpoint string) string {
+	if version, ok := c.versions[endpoint]; ok {
+		return version
+	}
+	if c.apiVersion != "" {
+		return c.apiVersion
+	}
+	return APIVersionBeta
+}
+
+// DetectAPIVersions asks the server which endpoints exist in the configured
+// versions and falls back to beta for the ones that do not. It returns the
+// version of every endpoint. The probes are sent with ctx and share a single
+// token.
+//
+// Only a 404 Not Found falls back to beta. An endpoint exists when its probe
+// succeeds or is refused with 401 Unauthorized, 403 Forbidden or 405 Method
+// Not Allowed, as an endpoint that does not exist cannot refuse the client.
+// Any other status, such as a server error, is returned as an error rather
+// than guessed at.
+func (c *Client) DetectAPIVersions(ctx context.Context) (map[string]string, error) {
+	var token string
+	detected := make(map[string]string, len(endpoints))
+	for _, endpoint := range endpoints {
+		version := c.EndpointVersion(endpoint.name)
+		if version == APIVersionBeta {
+			detected[endpoint.name] = version
+			continue
+		}
+
+		if token == "" && c.tokenSource != nil {
+			authResp, err := c.Token()
+			if err != nil && !errors.Is(err, ErrTokenNotCached) {
+				return nil, fmt.Errorf("failed to authenticate: %w", err)
+			}
+			token = authResp.AccessToken
+		}
+
+		req := c.HTTPClient.R().SetContext(ctx)
+		if token != "" {
+			req.SetAuthToken(token)
+		}
+		resp, err := req.Head(c.baseURL + version + "/" + endpoint.path)
+		if err != nil {
+			return nil, fmt.Errorf("detect version of %s: %w", endpoint.name, err)
+		}
+		switch status := resp.StatusCode(); {
+		case resp.IsSuccess(), status == http.StatusUnauthorized, status == http.StatusForbidden, status == http.StatusMethodNotAllowed:
+		case status == http.StatusNotFound:
+			version = APIVersionBeta
+			c.SetEndpointVersion(endpoint.name, version)
+		default:
+			return nil, fmt.Errorf("detect version of %s: unexpected status %d", endpoint.name, status)
+		}
+		detected[endpoint.name] = version
+	}
+
+	return detected, nil
+}
+
+// endpointURL returns the URL of path, e.g. "devices/NAME", in the version
+// of its endpoint.
+func (c *Client) endpointURL(path string) string {
+	return c.baseURL + c.versionedPath(path)
+}
+
+func (c *Client) versionedPath(path string) string {
+	endpoint, _, _ := strings.Cut(path, "/")
+	return c.EndpointVersion(endpoint) + "/" + path
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 CERN
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package landb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointVersion(t *testing.T) {
+	cli, err := New(WithEndpoint("https://landb.example.com/api"))
+	require.NoError(t, err)
+	require.Equal(t, "https://landb.example.com/api/beta/devices/TEST-DEVICE", cli.endpointURL(devicesURL+"TEST-DEVICE"))
+
+	cli, err = New(
+		WithEndpoint("https://landb.example.com/api"),
+		WithAPIVersion(APIVersionV1),
+		WithEndpointVersion("sets", APIVersionBeta),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "https://landb.example.com/api/v1/devices/TEST-DEVICE/interfaces/", cli.endpointURL("devices/TEST-DEVICE/interfaces/"))
+	require.Equal(t, "https://landb.example.com/api/beta/sets/TEST-SET/ip-addresses", cli.endpointURL("sets/TEST-SET/ip-addresses"))
+}
+
+func TestDetectAPIVersions(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		require.Equal(t, "Bearer landb-token", r.Header.Get("Authorization"))
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/v1/sets/"):
+			// Sets have not been ported to v1 yet.
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case r.URL.Path == "/v1/subnets/":
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		case r.URL.Path == "/v1/services/":
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		case r.URL.Path == "/v1/models":
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	source := &fakeTokenSource{authResp: AuthResponse{AccessToken: "landb-token"}}
+	cli, err := New(
+		WithEndpoint(server.URL),
+		WithTokenSource(source),
+		WithAPIVersion(APIVersionV1),
+		WithEndpointVersion("zones", APIVersionBeta),
+	)
+	require.NoError(t, err)
+
+	versions, err := cli.DetectAPIVersions(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, source.calls, "the probes share a token")
+	require.Equal(t, APIVersionV1, versions["devices"])
+	require.Equal(t, APIVersionV1, versions["services"])
+	require.Equal(t, APIVersionV1, versions["models"])
+	require.Equal(t, APIVersionV1, versions["subnets"])
+	require.Equal(t, APIVersionBeta, versions["sets"])
+	require.Equal(t, APIVersionBeta, versions["zones"])
+	require.Len(t, versions, len(endpoints))
+	require.Contains(t, paths, "HEAD /v1/devices/")
+	require.Contains(t, paths, "HEAD /v1/locations", "endpoints are probed at the path the client requests")
+	require.NotContains(t, paths, "HEAD /v1/zones", "endpoints pinned to beta are not probed")
+
+	paths = nil
+	_, err = cli.GetSetAttachments("TEST-SET")
+	require.NoError(t, err)
+	_, err = cli.ListLocations("")
+	require.NoError(t, err)
+
+	generated, err := cli.Endpoints()
+	require.NoError(t, err)
+	_, err = generated.ListManufacturersWithResponse(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"GET /beta/sets/TEST-SET/ip-addresses",
+		"GET /v1/locations",
+		"GET /v1/manufacturers",
+	}, paths)
+}
+
+func TestDetectAPIVersionsServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/subnets/" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cli, err := New(WithEndpoint(server.URL), WithAPIVersion(APIVersionV1))
+	require.NoError(t, err)
+
+	_, err = cli.DetectAPIVersions(context.Background())
+	require.EqualError(t, err, "detect version of subnets: unexpected status 500")
+	require.Equal(t, APIVersionV1, cli.EndpointVersion("subnets"))
+}
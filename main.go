@@ -7,7 +7,7 @@ package main
 import (
 	"context"
 	"flag"
-	"landb/internal/provider"
+	"github.com/barnes-c/terraform-provider-landb/internal/provider"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
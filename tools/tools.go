@@ -8,6 +8,8 @@ package tools
 
 import (
 	_ "github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs"
+	_ "github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen"
+	_ "go.uber.org/mock/mockgen"
 )
 
 // Format Terraform code for use in documentation.
@@ -17,3 +19,9 @@ import (
 
 // Generate documentation.
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs generate --provider-dir .. -provider-name landb
+
+// Generate the mock of the LanDB client used by the provider tests.
+//go:generate go run go.uber.org/mock/mockgen -source=../landb/api.go -destination=../landb/landbmock/api.go -package=landbmock
+
+// Generate the LanDB models and endpoint methods from the OpenAPI document.
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen -config ../landb/landbapi/oapi-codegen.yaml ../landb/landbapi/openapi.yaml